@@ -0,0 +1,117 @@
+package firehydrant
+
+import (
+	"context"
+	"time"
+
+	"github.com/dghubble/sling"
+	"github.com/pkg/errors"
+)
+
+// ChangeEventEntity is a service or environment a change event affected
+type ChangeEventEntity struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// ChangeEventResponse is the payload for retrieving a change event
+// URL: GET https://api.firehydrant.io/v1/changes/events/{id}
+type ChangeEventResponse struct {
+	ID           string              `json:"id"`
+	Summary      string              `json:"summary"`
+	Description  string              `json:"description"`
+	Services     []ChangeEventEntity `json:"services"`
+	Environments []ChangeEventEntity `json:"environments"`
+	StartsAt     time.Time           `json:"starts_at"`
+	CreatedAt    time.Time           `json:"created_at"`
+	UpdatedAt    time.Time           `json:"updated_at"`
+}
+
+// CreateChangeEventRequest is the payload for creating a change event
+// URL: POST https://api.firehydrant.io/v1/changes/events
+type CreateChangeEventRequest struct {
+	Summary      string   `json:"summary"`
+	Description  string   `json:"description,omitempty"`
+	Services     []string `json:"services,omitempty"`
+	Environments []string `json:"environments,omitempty"`
+}
+
+// UpdateChangeEventRequest is the payload for updating a change event. The services and environments
+// are always sent so that they can be cleared
+// URL: PATCH https://api.firehydrant.io/v1/changes/events/{id}
+type UpdateChangeEventRequest struct {
+	Summary      string   `json:"summary,omitempty"`
+	Description  string   `json:"description"`
+	Services     []string `json:"services"`
+	Environments []string `json:"environments"`
+}
+
+// ChangeEventsClient is an interface for interacting with change events on FireHydrant
+type ChangeEventsClient interface {
+	Get(ctx context.Context, id string) (*ChangeEventResponse, error)
+	Create(ctx context.Context, createReq CreateChangeEventRequest) (*ChangeEventResponse, error)
+	Update(ctx context.Context, id string, updateReq UpdateChangeEventRequest) (*ChangeEventResponse, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// RESTChangeEventsClient implements the ChangeEventsClient interface
+type RESTChangeEventsClient struct {
+	client *APIClient
+}
+
+var _ ChangeEventsClient = &RESTChangeEventsClient{}
+
+func (c *RESTChangeEventsClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
+}
+
+// Get returns a change event from the FireHydrant API
+func (c *RESTChangeEventsClient) Get(ctx context.Context, id string) (*ChangeEventResponse, error) {
+	res := &ChangeEventResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get("changes/events/"+id).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get change event")
+	}
+
+	return res, nil
+}
+
+// Create creates a change event in FireHydrant
+func (c *RESTChangeEventsClient) Create(ctx context.Context, createReq CreateChangeEventRequest) (*ChangeEventResponse, error) {
+	res := &ChangeEventResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Post("changes/events").BodyJSON(&createReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not create change event")
+	}
+
+	return res, nil
+}
+
+// Update updates a change event in FireHydrant
+func (c *RESTChangeEventsClient) Update(ctx context.Context, id string, updateReq UpdateChangeEventRequest) (*ChangeEventResponse, error) {
+	res := &ChangeEventResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Patch("changes/events/"+id).BodyJSON(&updateReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not update change event")
+	}
+
+	return res, nil
+}
+
+// Delete deletes a change event from FireHydrant
+func (c *RESTChangeEventsClient) Delete(ctx context.Context, id string) error {
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Delete("changes/events/"+id).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not delete change event")
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package firehydrant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateChangeEvent(t *testing.T) {
+	resp := &ChangeEventResponse{}
+	req := CreateChangeEventRequest{Summary: "Deploy api", Services: []string{"test-service-id"}}
+	c, teardown, err := setupClient("/changes/events", resp,
+		AssertRequestJSONBody(t, req),
+		AssertRequestMethod(t, "POST"),
+	)
+	require.NoError(t, err)
+	defer teardown()
+
+	_, err = c.ChangeEvents().Create(context.TODO(), req)
+	require.NoError(t, err, "error creating a change event")
+}
+
+func TestUpdateChangeEventClearsAffected(t *testing.T) {
+	resp := &ChangeEventResponse{}
+	testChangeEventID := "test-change-event-id"
+	c, teardown, err := setupClient("/changes/events/"+testChangeEventID, resp,
+		AssertRequestJSONBody(t, struct {
+			Summary      string   `json:"summary"`
+			Description  string   `json:"description"`
+			Services     []string `json:"services"`
+			Environments []string `json:"environments"`
+		}{Summary: "Deploy api", Services: []string{}, Environments: []string{}}),
+		AssertRequestMethod(t, "PATCH"),
+	)
+	require.NoError(t, err)
+	defer teardown()
+
+	_, err = c.ChangeEvents().Update(context.TODO(), testChangeEventID, UpdateChangeEventRequest{
+		Summary:      "Deploy api",
+		Services:     []string{},
+		Environments: []string{},
+	})
+	require.NoError(t, err, "error updating a change event")
+}
@@ -2,37 +2,42 @@ package firehydrant
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/dghubble/sling"
 	"github.com/pkg/errors"
 )
 
-const (
-	// MajorVersion is the major version
-	MajorVersion = 0
-	// MinorVersion is the minor version
-	MinorVersion = 1
-	// PatchVersion is the patch version
-	PatchVersion = 0
-
-	// UserAgentPrefix is the prefix of the User-Agent header that all terraform REST calls perform
-	UserAgentPrefix = "firehydrant-terraform-provider"
-)
-
-type NotFound string
-
-func (nf NotFound) Error() string {
-	return string(nf)
-}
+// UserAgentPrefix is the product name in the User-Agent header sent with every request
+const UserAgentPrefix = "terraform-provider-firehydrant"
 
-// Version is the semver of this provider
-var Version = fmt.Sprintf("%d.%d.%d", MajorVersion, MinorVersion, PatchVersion)
+// Version is the version of the provider making requests. Release builds set it with
+// -ldflags "-X github.com/firehydrant/terraform-provider-firehydrant/firehydrant.Version=x.y.z"
+var Version = "dev"
 
 // APIClient is the client that accesses all of the api.firehydrant.io resources
 type APIClient struct {
-	baseURL string
-	token   string
+	baseURL        string
+	token          string
+	maxRetries     int
+	retryBaseDelay time.Duration
+	userAgent      string
+	transport      http.RoundTripper
+	httpClient     *http.Client
+	runbookActions *runbookActionsCache
+	cacheTTL       time.Duration
+	requestTimeout time.Duration
+	maxConcurrent  int
+
+	rateLimitObservers []RateLimitObserver
+	requestLogger      RequestLogger
 }
 
 const (
@@ -49,27 +54,56 @@ type Client interface {
 	Services() ServicesClient
 	Runbooks() RunbooksClient
 	RunbookActions() RunbookActionsClient
+	Schedules() SchedulesClient
+	EscalationPolicies() EscalationPoliciesClient
+	ServiceDependencies() ServiceDependenciesClient
+	IncidentRoles() IncidentRolesClient
+	Priorities() PrioritiesClient
+	SignalRules() SignalRulesClient
+	TaskLists() TaskListsClient
+	Webhooks() WebhooksClient
+	Users() UsersClient
+	IncidentTypes() IncidentTypesClient
+	StatusPageComponents() StatusPageComponentsClient
+	Integrations() IntegrationsClient
+	ChangeEvents() ChangeEventsClient
+	Workflows() WorkflowsClient
+	ScheduledMaintenances() ScheduledMaintenancesClient
+	OnCallOverrides() OnCallOverridesClient
+	RetrospectiveTemplates() RetrospectiveTemplatesClient
+	SignalsEmailTargets() SignalsEmailTargetsClient
+	Incidents() IncidentsClient
+	SavedSearches() SavedSearchesClient
 
 	// Environments
 	GetEnvironment(ctx context.Context, id string) (*EnvironmentResponse, error)
+	ListEnvironments(ctx context.Context, req *EnvironmentQuery) (*EnvironmentsResponse, error)
 	CreateEnvironment(ctx context.Context, req CreateEnvironmentRequest) (*EnvironmentResponse, error)
 	UpdateEnvironment(ctx context.Context, id string, req UpdateEnvironmentRequest) (*EnvironmentResponse, error)
 	DeleteEnvironment(ctx context.Context, id string) error
+	DestroyEnvironment(ctx context.Context, id string) error
 
 	// Functionalities
 	GetFunctionality(ctx context.Context, id string) (*FunctionalityResponse, error)
+	ListFunctionalities(ctx context.Context, req *FunctionalityQuery) (*FunctionalitiesResponse, error)
 	CreateFunctionality(ctx context.Context, req CreateFunctionalityRequest) (*FunctionalityResponse, error)
 	UpdateFunctionality(ctx context.Context, id string, req UpdateFunctionalityRequest) (*FunctionalityResponse, error)
 	DeleteFunctionality(ctx context.Context, id string) error
 
 	// Teams
 	GetTeam(ctx context.Context, id string) (*TeamResponse, error)
+	ListTeams(ctx context.Context, req *TeamQuery) (*TeamsResponse, error)
 	CreateTeam(ctx context.Context, req CreateTeamRequest) (*TeamResponse, error)
 	UpdateTeam(ctx context.Context, id string, req UpdateTeamRequest) (*TeamResponse, error)
 	DeleteTeam(ctx context.Context, id string) error
+	CreateTeamMembership(ctx context.Context, teamID string, req TeamMembership) error
+	DeleteTeamMembership(ctx context.Context, teamID, userID string) error
+	CreateTeamDefaultIncidentRole(ctx context.Context, teamID string, req TeamDefaultIncidentRole) error
+	DeleteTeamDefaultIncidentRole(ctx context.Context, teamID, incidentRoleID string) error
 
 	// Severities
 	GetSeverity(ctx context.Context, slug string) (*SeverityResponse, error)
+	ListSeverities(ctx context.Context) (*SeveritiesResponse, error)
 	CreateSeverity(ctx context.Context, req CreateSeverityRequest) (*SeverityResponse, error)
 	UpdateSeverity(ctx context.Context, slug string, req UpdateSeverityRequest) (*SeverityResponse, error)
 	DeleteSeverity(ctx context.Context, slug string) error
@@ -81,7 +115,149 @@ type OptFunc func(c *APIClient) error
 // WithBaseURL modifies the base URL for all requests
 func WithBaseURL(baseURL string) OptFunc {
 	return func(c *APIClient) error {
-		c.baseURL = baseURL
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return fmt.Errorf("could not parse base URL %q: %w", baseURL, err)
+		}
+
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("base URL %q must be an absolute http or https URL, such as %s", baseURL, DefaultBaseURL)
+		}
+
+		// Request paths are resolved relative to the base URL, which would drop its last path segment
+		// (such as the v1 in https://api.firehydrant.io/v1) without a trailing slash
+		if !strings.HasSuffix(u.Path, "/") {
+			u.Path += "/"
+		}
+
+		c.baseURL = u.String()
+		return nil
+	}
+}
+
+// WithTransport sends requests through the given transport instead of http.DefaultTransport. Failed
+// requests are still retried on top of it
+func WithTransport(transport http.RoundTripper) OptFunc {
+	return func(c *APIClient) error {
+		c.transport = transport
+		return nil
+	}
+}
+
+// WithCACertFile trusts the PEM encoded certificates in a file along with the system's, for reaching
+// FireHydrant through a proxy that uses an internal certificate authority. Proxies set with
+// HTTPS_PROXY and the other proxy environment variables are still used
+func WithCACertFile(path string) OptFunc {
+	return func(c *APIClient) error {
+		pem, err := ioutil.ReadFile(path)
+		if err != nil {
+			return errors.Wrap(err, "could not read CA certificate file")
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no PEM encoded certificates found in %s", path)
+		}
+
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.Proxy = http.ProxyFromEnvironment
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+
+		c.transport = transport
+		return nil
+	}
+}
+
+// WithUserAgent replaces the User-Agent header sent with every request
+func WithUserAgent(userAgent string) OptFunc {
+	return func(c *APIClient) error {
+		c.userAgent = userAgent
+		return nil
+	}
+}
+
+// DefaultUserAgent is the User-Agent header sent with every request unless WithUserAgent is used,
+// such as terraform-provider-firehydrant/1.2.3
+func DefaultUserAgent() string {
+	return UserAgentPrefix + "/" + Version
+}
+
+// WithRetries configures how many times rate limited or failed requests are retried and
+// the base delay used for exponential backoff between attempts
+func WithRetries(maxRetries int, baseDelay time.Duration) OptFunc {
+	return func(c *APIClient) error {
+		if maxRetries < 0 {
+			return fmt.Errorf("max retries must not be negative, got %d", maxRetries)
+		}
+
+		if baseDelay < 0 {
+			return fmt.Errorf("retry base delay must not be negative, got %s", baseDelay)
+		}
+
+		c.maxRetries = maxRetries
+		c.retryBaseDelay = baseDelay
+		return nil
+	}
+}
+
+// WithRateLimitObserver calls observer with FireHydrant's rate limit after every request that reports
+// it, including each retry. It can be used more than once to register several observers
+func WithRateLimitObserver(observer RateLimitObserver) OptFunc {
+	return func(c *APIClient) error {
+		c.rateLimitObservers = append(c.rateLimitObservers, observer)
+		return nil
+	}
+}
+
+// WithRequestLogger describes every request to FireHydrant and its response to logger, including
+// each retry
+func WithRequestLogger(logger RequestLogger) OptFunc {
+	return func(c *APIClient) error {
+		c.requestLogger = logger
+		return nil
+	}
+}
+
+// WithResponseCache answers repeated GET requests for the same URL from memory for ttl, such as a
+// data source looking up the same team many times in one run. Creating, updating, or deleting a
+// resource clears what was cached for that kind of resource
+func WithResponseCache(ttl time.Duration) OptFunc {
+	return func(c *APIClient) error {
+		if ttl < 0 {
+			return fmt.Errorf("response cache TTL must not be negative, got %s", ttl)
+		}
+
+		c.cacheTTL = ttl
+		return nil
+	}
+}
+
+// WithRequestTimeout gives up on a request to FireHydrant, including its retries, once timeout has
+// passed. Use WithOperationTimeout to give the requests made for one operation longer or shorter
+func WithRequestTimeout(timeout time.Duration) OptFunc {
+	return func(c *APIClient) error {
+		if timeout < 0 {
+			return fmt.Errorf("request timeout must not be negative, got %s", timeout)
+		}
+
+		c.requestTimeout = timeout
+		return nil
+	}
+}
+
+// WithMaxConcurrentRequests bounds how many requests to FireHydrant are in flight at once, no matter
+// how many operations are running. Requests over the limit wait for an earlier one to finish, and a
+// retry waits for a slot like any other request. A limit of zero leaves requests unbounded
+func WithMaxConcurrentRequests(limit int) OptFunc {
+	return func(c *APIClient) error {
+		if limit < 0 {
+			return fmt.Errorf("max concurrent requests must not be negative, got %d", limit)
+		}
+
+		c.maxConcurrent = limit
 		return nil
 	}
 }
@@ -89,8 +265,12 @@ func WithBaseURL(baseURL string) OptFunc {
 // NewRestClient initializes a new API client for FireHydrant
 func NewRestClient(token string, opts ...OptFunc) (*APIClient, error) {
 	c := &APIClient{
-		baseURL: DefaultBaseURL,
-		token:   token,
+		baseURL:        DefaultBaseURL,
+		token:          token,
+		maxRetries:     DefaultMaxRetries,
+		retryBaseDelay: DefaultRetryBaseDelay,
+		userAgent:      DefaultUserAgent(),
+		runbookActions: &runbookActionsCache{actions: map[string]*RunbookActionsResponse{}},
 	}
 
 	for _, f := range opts {
@@ -99,21 +279,60 @@ func NewRestClient(token string, opts ...OptFunc) (*APIClient, error) {
 		}
 	}
 
+	transport := c.transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	if c.requestLogger != nil {
+		transport = &loggingTransport{
+			next:   transport,
+			logger: c.requestLogger,
+		}
+	}
+
+	if len(c.rateLimitObservers) > 0 {
+		transport = &rateLimitTransport{
+			next:      transport,
+			observers: c.rateLimitObservers,
+		}
+	}
+
+	// Backoff between retries happens outside of the limit, so waiting out a 429 does not hold a slot
+	if c.maxConcurrent > 0 {
+		transport = newConcurrencyLimitTransport(transport, c.maxConcurrent)
+	}
+
+	transport = &retryTransport{
+		next:       transport,
+		maxRetries: c.maxRetries,
+		baseDelay:  c.retryBaseDelay,
+	}
+
+	// Cached responses skip retries and rate limit reporting, since no request is made
+	if c.cacheTTL > 0 {
+		transport = newResponseCacheTransport(transport, c.cacheTTL, c.baseURL)
+	}
+
+	c.httpClient = &http.Client{Transport: transport}
+
 	return c, nil
 }
 
-func (c *APIClient) client() *sling.Sling {
-	return sling.New().Base(c.baseURL).
-		Set("User-Agent", fmt.Sprintf("%s (%s)", UserAgentPrefix, Version)).
+func (c *APIClient) client(ctx context.Context) *sling.Sling {
+	doer := &contextDoer{client: c.httpClient, ctx: ctx, timeout: c.requestTimeout}
+	return sling.New().Doer(doer).Base(c.baseURL).ResponseDecoder(responseDecoder{}).
+		Set("User-Agent", c.userAgent).
 		Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
 }
 
 // Ping hits and verifies the HTTP of FireHydrant
-// TODO: Check failure case
 func (c *APIClient) Ping(ctx context.Context) (*PingResponse, error) {
 	res := &PingResponse{}
+	apiErr := &APIError{}
 
-	if _, err := c.client().Get("ping").Receive(res, nil); err != nil {
+	resp, err := c.client(ctx).Get("ping").Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
 		return nil, errors.Wrap(err, "could not ping")
 	}
 
@@ -134,50 +353,189 @@ func (c *APIClient) RunbookActions() RunbookActionsClient {
 	return &RESTRunbookActionsClient{client: c}
 }
 
-// UpdateService updates a old spankin service in FireHydrant
-// TODO: Check failure case
-func (c *APIClient) UpdateService(ctx context.Context, serviceID string, updateReq UpdateServiceRequest) (*ServiceResponse, error) {
-	res := &ServiceResponse{}
+// Schedules returns a SchedulesClient interface for interacting with on-call schedules in FireHydrant
+func (c *APIClient) Schedules() SchedulesClient {
+	return &RESTSchedulesClient{client: c}
+}
 
-	if _, err := c.client().Patch("services/"+serviceID).BodyJSON(&updateReq).Receive(res, nil); err != nil {
-		return nil, errors.Wrap(err, "could not update service")
-	}
+// EscalationPolicies returns an EscalationPoliciesClient interface for interacting with escalation policies in FireHydrant
+func (c *APIClient) EscalationPolicies() EscalationPoliciesClient {
+	return &RESTEscalationPoliciesClient{client: c}
+}
 
-	return res, nil
+// ServiceDependencies returns a ServiceDependenciesClient interface for interacting with service dependencies in FireHydrant
+func (c *APIClient) ServiceDependencies() ServiceDependenciesClient {
+	return &RESTServiceDependenciesClient{client: c}
+}
+
+// IncidentRoles returns an IncidentRolesClient interface for interacting with incident roles in FireHydrant
+func (c *APIClient) IncidentRoles() IncidentRolesClient {
+	return &RESTIncidentRolesClient{client: c}
+}
+
+// Priorities returns a PrioritiesClient interface for interacting with priorities in FireHydrant
+func (c *APIClient) Priorities() PrioritiesClient {
+	return &RESTPrioritiesClient{client: c}
+}
+
+// SignalRules returns a SignalRulesClient interface for interacting with signal rules in FireHydrant
+func (c *APIClient) SignalRules() SignalRulesClient {
+	return &RESTSignalRulesClient{client: c}
+}
+
+// TaskLists returns a TaskListsClient interface for interacting with task lists in FireHydrant
+func (c *APIClient) TaskLists() TaskListsClient {
+	return &RESTTaskListsClient{client: c}
+}
+
+// Webhooks returns a WebhooksClient interface for interacting with webhooks in FireHydrant
+func (c *APIClient) Webhooks() WebhooksClient {
+	return &RESTWebhooksClient{client: c}
+}
+
+// Users returns a UsersClient interface for interacting with users in FireHydrant
+func (c *APIClient) Users() UsersClient {
+	return &RESTUsersClient{client: c}
+}
+
+// IncidentTypes returns an IncidentTypesClient interface for interacting with incident types in FireHydrant
+func (c *APIClient) IncidentTypes() IncidentTypesClient {
+	return &RESTIncidentTypesClient{client: c}
+}
+
+// StatusPageComponents returns a StatusPageComponentsClient interface for interacting with the components of status pages in FireHydrant
+func (c *APIClient) StatusPageComponents() StatusPageComponentsClient {
+	return &RESTStatusPageComponentsClient{client: c}
+}
+
+// Integrations returns an IntegrationsClient interface for interacting with integrations in FireHydrant
+func (c *APIClient) Integrations() IntegrationsClient {
+	return &RESTIntegrationsClient{client: c}
+}
+
+// ChangeEvents returns a ChangeEventsClient interface for interacting with change events in FireHydrant
+func (c *APIClient) ChangeEvents() ChangeEventsClient {
+	return &RESTChangeEventsClient{client: c}
+}
+
+// Workflows returns a WorkflowsClient interface for interacting with workflows in FireHydrant
+func (c *APIClient) Workflows() WorkflowsClient {
+	return &RESTWorkflowsClient{client: c}
+}
+
+// ScheduledMaintenances returns a ScheduledMaintenancesClient interface for interacting with scheduled maintenances in FireHydrant
+func (c *APIClient) ScheduledMaintenances() ScheduledMaintenancesClient {
+	return &RESTScheduledMaintenancesClient{client: c}
+}
+
+// OnCallOverrides returns an OnCallOverridesClient interface for interacting with overrides of on-call schedules in FireHydrant
+func (c *APIClient) OnCallOverrides() OnCallOverridesClient {
+	return &RESTOnCallOverridesClient{client: c}
+}
+
+// RetrospectiveTemplates returns a RetrospectiveTemplatesClient interface for interacting with retrospective templates in FireHydrant
+func (c *APIClient) RetrospectiveTemplates() RetrospectiveTemplatesClient {
+	return &RESTRetrospectiveTemplatesClient{client: c}
+}
+
+// SignalsEmailTargets returns a SignalsEmailTargetsClient interface for interacting with signals email targets in FireHydrant
+func (c *APIClient) SignalsEmailTargets() SignalsEmailTargetsClient {
+	return &RESTSignalsEmailTargetsClient{client: c}
+}
+
+// Incidents returns an IncidentsClient interface for interacting with incidents in FireHydrant
+func (c *APIClient) Incidents() IncidentsClient {
+	return &RESTIncidentsClient{client: c}
+}
+
+// SavedSearches returns a SavedSearchesClient interface for interacting with saved searches in FireHydrant
+func (c *APIClient) SavedSearches() SavedSearchesClient {
+	return &RESTSavedSearchesClient{client: c}
+}
+
+// UpdateService updates a old spankin service in FireHydrant
+func (c *APIClient) UpdateService(ctx context.Context, serviceID string, updateReq UpdateServiceRequest) (*ServiceResponse, error) {
+	return c.Services().Update(ctx, serviceID, updateReq)
 }
 
 // DeleteService updates a old spankin service in FireHydrant
-// TODO: Check failure case
 func (c *APIClient) DeleteService(ctx context.Context, serviceID string) error {
-	if _, err := c.client().Delete("services/"+serviceID).Receive(nil, nil); err != nil {
-		return errors.Wrap(err, "could not delete service")
-	}
-
-	return nil
+	return c.Services().Delete(ctx, serviceID)
 }
 
 // GetEnvironment retrieves an environment from the FireHydrant API
 func (c *APIClient) GetEnvironment(ctx context.Context, id string) (*EnvironmentResponse, error) {
-	var env EnvironmentResponse
+	res := &EnvironmentResponse{}
+	apiErr := &APIError{}
 
-	resp, err := c.client().Get("environments/"+id).Receive(&env, nil)
+	resp, err := c.client(ctx).Get("environments/"+id).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not retrieve environment")
+	}
 
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("status code was not a 200, got %d", resp.StatusCode)
+	return res, nil
+}
+
+// ListEnvironments retrieves the environments matching a query. If the query does not request a
+// specific page, every page is fetched and the environments are combined in page order
+func (c *APIClient) ListEnvironments(ctx context.Context, req *EnvironmentQuery) (*EnvironmentsResponse, error) {
+	if req == nil {
+		req = &EnvironmentQuery{}
+	}
+
+	if req.Page != 0 {
+		return c.listEnvironmentsPage(ctx, req)
 	}
 
+	firstReq := *req
+	firstReq.Page = 1
+	res, err := c.listEnvironmentsPage(ctx, &firstReq)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not retrieve environment")
+		return nil, err
+	}
+
+	if res.Pagination == nil || res.Pagination.TotalPages <= 1 {
+		return res, nil
 	}
 
-	return &env, nil
+	pages := make([]*EnvironmentsResponse, res.Pagination.TotalPages+1)
+	err = fetchRemainingPages(ctx, res.Pagination.TotalPages, c.pageWorkers(), func(ctx context.Context, number int) error {
+		pageReq := *req
+		pageReq.Page = number
+		page, err := c.listEnvironmentsPage(ctx, &pageReq)
+		pages[number] = page
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, page := range pages[2:] {
+		res.Environments = append(res.Environments, page.Environments...)
+	}
+
+	return res, nil
+}
+
+func (c *APIClient) listEnvironmentsPage(ctx context.Context, req *EnvironmentQuery) (*EnvironmentsResponse, error) {
+	res := &EnvironmentsResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.client(ctx).Get("environments").QueryStruct(req).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not retrieve environments")
+	}
+
+	return res, nil
 }
 
 // CreateEnvironment creates an environment
 func (c *APIClient) CreateEnvironment(ctx context.Context, req CreateEnvironmentRequest) (*EnvironmentResponse, error) {
 	res := &EnvironmentResponse{}
+	apiErr := &APIError{}
 
-	if _, err := c.client().Post("environments").BodyJSON(&req).Receive(res, nil); err != nil {
+	resp, err := c.client(ctx).Post("environments").BodyJSON(&req).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
 		return nil, errors.Wrap(err, "could not create environment")
 	}
 
@@ -187,18 +545,37 @@ func (c *APIClient) CreateEnvironment(ctx context.Context, req CreateEnvironment
 // UpdateEnvironment updates a environment in FireHydrant
 func (c *APIClient) UpdateEnvironment(ctx context.Context, id string, req UpdateEnvironmentRequest) (*EnvironmentResponse, error) {
 	res := &EnvironmentResponse{}
+	apiErr := &APIError{}
 
-	if _, err := c.client().Patch("environments/"+id).BodyJSON(&req).Receive(res, nil); err != nil {
+	resp, err := c.client(ctx).Patch("environments/"+id).BodyJSON(&req).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
 		return nil, errors.Wrap(err, "could not update environment")
 	}
 
 	return res, nil
 }
 
-// DeleteEnvironment deletes a environment record from FireHydrant
+// DeleteEnvironment archives an environment in FireHydrant, which keeps its incident history
+// URL: DELETE https://api.firehydrant.io/v1/environments/{id}
 func (c *APIClient) DeleteEnvironment(ctx context.Context, id string) error {
-	if _, err := c.client().Delete("environments/"+id).Receive(nil, nil); err != nil {
-		return errors.Wrap(err, "could not delete service")
+	apiErr := &APIError{}
+
+	resp, err := c.client(ctx).Delete("environments/"+id).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not delete environment")
+	}
+
+	return nil
+}
+
+// DestroyEnvironment permanently deletes an environment in FireHydrant, along with its incident history
+// URL: DELETE https://api.firehydrant.io/v1/environments/{id}/destroy
+func (c *APIClient) DestroyEnvironment(ctx context.Context, id string) error {
+	apiErr := &APIError{}
+
+	resp, err := c.client(ctx).Delete("environments/"+id+"/destroy").Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not destroy environment")
 	}
 
 	return nil
@@ -206,26 +583,77 @@ func (c *APIClient) DeleteEnvironment(ctx context.Context, id string) error {
 
 // GetFunctionality retrieves an functionality from the FireHydrant API
 func (c *APIClient) GetFunctionality(ctx context.Context, id string) (*FunctionalityResponse, error) {
-	var fun FunctionalityResponse
+	res := &FunctionalityResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.client(ctx).Get("functionalities/"+id).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not retrieve functionality")
+	}
+
+	return res, nil
+}
 
-	resp, err := c.client().Get("functionalities/"+id).Receive(&fun, nil)
+// ListFunctionalities retrieves the functionalities matching a query. If the query does not request a
+// specific page, every page is fetched and the functionalities are combined in page order
+func (c *APIClient) ListFunctionalities(ctx context.Context, req *FunctionalityQuery) (*FunctionalitiesResponse, error) {
+	if req == nil {
+		req = &FunctionalityQuery{}
+	}
 
-	if resp.StatusCode == 404 {
-		return nil, NotFound(fmt.Sprintf("Could not find functionality with ID %s", id))
+	if req.Page != 0 {
+		return c.listFunctionalitiesPage(ctx, req)
 	}
 
+	firstReq := *req
+	firstReq.Page = 1
+	res, err := c.listFunctionalitiesPage(ctx, &firstReq)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not retrieve functionality")
+		return nil, err
+	}
+
+	if res.Pagination == nil || res.Pagination.TotalPages <= 1 {
+		return res, nil
+	}
+
+	pages := make([]*FunctionalitiesResponse, res.Pagination.TotalPages+1)
+	err = fetchRemainingPages(ctx, res.Pagination.TotalPages, c.pageWorkers(), func(ctx context.Context, number int) error {
+		pageReq := *req
+		pageReq.Page = number
+		page, err := c.listFunctionalitiesPage(ctx, &pageReq)
+		pages[number] = page
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return &fun, nil
+	for _, page := range pages[2:] {
+		res.Functionalities = append(res.Functionalities, page.Functionalities...)
+	}
+
+	return res, nil
+}
+
+func (c *APIClient) listFunctionalitiesPage(ctx context.Context, req *FunctionalityQuery) (*FunctionalitiesResponse, error) {
+	res := &FunctionalitiesResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.client(ctx).Get("functionalities").QueryStruct(req).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not retrieve functionalities")
+	}
+
+	return res, nil
 }
 
 // CreateFunctionality creates an functionality
 func (c *APIClient) CreateFunctionality(ctx context.Context, req CreateFunctionalityRequest) (*FunctionalityResponse, error) {
 	res := &FunctionalityResponse{}
+	apiErr := &APIError{}
 
-	if _, err := c.client().Post("functionalities").BodyJSON(&req).Receive(res, nil); err != nil {
+	resp, err := c.client(ctx).Post("functionalities").BodyJSON(&req).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
 		return nil, errors.Wrap(err, "could not create functionality")
 	}
 
@@ -235,8 +663,10 @@ func (c *APIClient) CreateFunctionality(ctx context.Context, req CreateFunctiona
 // UpdateFunctionality updates a functionality in FireHydrant
 func (c *APIClient) UpdateFunctionality(ctx context.Context, id string, req UpdateFunctionalityRequest) (*FunctionalityResponse, error) {
 	res := &FunctionalityResponse{}
+	apiErr := &APIError{}
 
-	if _, err := c.client().Patch("functionalities/"+id).BodyJSON(&req).Receive(res, nil); err != nil {
+	resp, err := c.client(ctx).Patch("functionalities/"+id).BodyJSON(&req).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
 		return nil, errors.Wrap(err, "could not update functionality")
 	}
 
@@ -245,8 +675,11 @@ func (c *APIClient) UpdateFunctionality(ctx context.Context, id string, req Upda
 
 // DeleteFunctionality deletes a functionality record from FireHydrant
 func (c *APIClient) DeleteFunctionality(ctx context.Context, id string) error {
-	if _, err := c.client().Delete("functionalities/"+id).Receive(nil, nil); err != nil {
-		return errors.Wrap(err, "could not delete service")
+	apiErr := &APIError{}
+
+	resp, err := c.client(ctx).Delete("functionalities/"+id).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not delete functionality")
 	}
 
 	return nil
@@ -254,26 +687,77 @@ func (c *APIClient) DeleteFunctionality(ctx context.Context, id string) error {
 
 // GetTeam retrieves an team from the FireHydrant API
 func (c *APIClient) GetTeam(ctx context.Context, id string) (*TeamResponse, error) {
-	var fun TeamResponse
+	res := &TeamResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.client(ctx).Get("teams/"+id).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not retrieve team")
+	}
+
+	return res, nil
+}
 
-	resp, err := c.client().Get("teams/"+id).Receive(&fun, nil)
+// ListTeams retrieves the teams matching a query. If the query does not request a specific
+// page, every page is fetched and the teams are combined in page order
+func (c *APIClient) ListTeams(ctx context.Context, req *TeamQuery) (*TeamsResponse, error) {
+	if req == nil {
+		req = &TeamQuery{}
+	}
 
-	if resp.StatusCode == 404 {
-		return nil, NotFound(fmt.Sprintf("Could not find team with ID %s", id))
+	if req.Page != 0 {
+		return c.listTeamsPage(ctx, req)
 	}
 
+	firstReq := *req
+	firstReq.Page = 1
+	res, err := c.listTeamsPage(ctx, &firstReq)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not retrieve team")
+		return nil, err
+	}
+
+	if res.Pagination == nil || res.Pagination.TotalPages <= 1 {
+		return res, nil
 	}
 
-	return &fun, nil
+	pages := make([]*TeamsResponse, res.Pagination.TotalPages+1)
+	err = fetchRemainingPages(ctx, res.Pagination.TotalPages, c.pageWorkers(), func(ctx context.Context, number int) error {
+		pageReq := *req
+		pageReq.Page = number
+		page, err := c.listTeamsPage(ctx, &pageReq)
+		pages[number] = page
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, page := range pages[2:] {
+		res.Teams = append(res.Teams, page.Teams...)
+	}
+
+	return res, nil
+}
+
+func (c *APIClient) listTeamsPage(ctx context.Context, req *TeamQuery) (*TeamsResponse, error) {
+	res := &TeamsResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.client(ctx).Get("teams").QueryStruct(req).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not retrieve teams")
+	}
+
+	return res, nil
 }
 
 // CreateTeam creates an team
 func (c *APIClient) CreateTeam(ctx context.Context, req CreateTeamRequest) (*TeamResponse, error) {
 	res := &TeamResponse{}
+	apiErr := &APIError{}
 
-	if _, err := c.client().Post("teams").BodyJSON(&req).Receive(res, nil); err != nil {
+	resp, err := c.client(ctx).Post("teams").BodyJSON(&req).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
 		return nil, errors.Wrap(err, "could not create team")
 	}
 
@@ -283,8 +767,10 @@ func (c *APIClient) CreateTeam(ctx context.Context, req CreateTeamRequest) (*Tea
 // UpdateTeam updates a team in FireHydrant
 func (c *APIClient) UpdateTeam(ctx context.Context, id string, req UpdateTeamRequest) (*TeamResponse, error) {
 	res := &TeamResponse{}
+	apiErr := &APIError{}
 
-	if _, err := c.client().Patch("teams/"+id).BodyJSON(&req).Receive(res, nil); err != nil {
+	resp, err := c.client(ctx).Patch("teams/"+id).BodyJSON(&req).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
 		return nil, errors.Wrap(err, "could not update team")
 	}
 
@@ -293,8 +779,59 @@ func (c *APIClient) UpdateTeam(ctx context.Context, id string, req UpdateTeamReq
 
 // DeleteTeam deletes a team record from FireHydrant
 func (c *APIClient) DeleteTeam(ctx context.Context, id string) error {
-	if _, err := c.client().Delete("teams/"+id).Receive(nil, nil); err != nil {
-		return errors.Wrap(err, "could not delete service")
+	apiErr := &APIError{}
+
+	resp, err := c.client(ctx).Delete("teams/"+id).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not delete team")
+	}
+
+	return nil
+}
+
+// CreateTeamMembership adds a user to a team in FireHydrant
+func (c *APIClient) CreateTeamMembership(ctx context.Context, teamID string, req TeamMembership) error {
+	apiErr := &APIError{}
+
+	resp, err := c.client(ctx).Post("teams/"+teamID+"/memberships").BodyJSON(&req).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not create team membership")
+	}
+
+	return nil
+}
+
+// DeleteTeamMembership removes a user from a team in FireHydrant
+func (c *APIClient) DeleteTeamMembership(ctx context.Context, teamID, userID string) error {
+	apiErr := &APIError{}
+
+	resp, err := c.client(ctx).Delete("teams/"+teamID+"/memberships/"+userID).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not delete team membership")
+	}
+
+	return nil
+}
+
+// CreateTeamDefaultIncidentRole adds an incident role that is filled in when a team declares an incident
+func (c *APIClient) CreateTeamDefaultIncidentRole(ctx context.Context, teamID string, req TeamDefaultIncidentRole) error {
+	apiErr := &APIError{}
+
+	resp, err := c.client(ctx).Post("teams/"+teamID+"/default_incident_roles").BodyJSON(&req).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not create team default incident role")
+	}
+
+	return nil
+}
+
+// DeleteTeamDefaultIncidentRole removes an incident role from the ones filled in when a team declares an incident
+func (c *APIClient) DeleteTeamDefaultIncidentRole(ctx context.Context, teamID, incidentRoleID string) error {
+	apiErr := &APIError{}
+
+	resp, err := c.client(ctx).Delete("teams/"+teamID+"/default_incident_roles/"+incidentRoleID).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not delete team default incident role")
 	}
 
 	return nil
@@ -302,42 +839,77 @@ func (c *APIClient) DeleteTeam(ctx context.Context, id string) error {
 
 // GetSeverity retrieves an severity from the FireHydrant API
 func (c *APIClient) GetSeverity(ctx context.Context, slug string) (*SeverityResponse, error) {
-	var fun SeverityResponse
+	res := &SeverityResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.client(ctx).Get("severities/"+slug).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not retrieve severity")
+	}
+
+	return res, nil
+}
 
-	resp, err := c.client().Get("severities/"+slug).Receive(&fun, nil)
+// ListSeverities retrieves every severity from the FireHydrant API, combining all pages of results
+func (c *APIClient) ListSeverities(ctx context.Context) (*SeveritiesResponse, error) {
+	res, err := c.listSeveritiesPage(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
 
-	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		return nil, NotFound(fmt.Sprintf("Could not find severity with ID %s", slug))
+	if res.Pagination == nil || res.Pagination.TotalPages <= 1 {
+		return res, nil
 	}
 
+	pages := make([]*SeveritiesResponse, res.Pagination.TotalPages+1)
+	err = fetchRemainingPages(ctx, res.Pagination.TotalPages, c.pageWorkers(), func(ctx context.Context, number int) error {
+		page, err := c.listSeveritiesPage(ctx, number)
+		pages[number] = page
+		return err
+	})
 	if err != nil {
-		return nil, errors.Wrap(err, "could not retrieve severity")
+		return nil, err
+	}
+
+	for _, page := range pages[2:] {
+		res.Severities = append(res.Severities, page.Severities...)
+	}
+
+	return res, nil
+}
+
+func (c *APIClient) listSeveritiesPage(ctx context.Context, number int) (*SeveritiesResponse, error) {
+	res := &SeveritiesResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.client(ctx).Get("severities").QueryStruct(&SeverityQuery{Page: number}).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not retrieve severities")
 	}
 
-	return &fun, nil
+	return res, nil
 }
 
 // CreateSeverity creates an severity
 func (c *APIClient) CreateSeverity(ctx context.Context, req CreateSeverityRequest) (*SeverityResponse, error) {
 	res := &SeverityResponse{}
+	apiErr := &APIError{}
 
-	resp, err := c.client().Post("severities").BodyJSON(&req).Receive(res, nil)
-	if err != nil {
+	resp, err := c.client(ctx).Post("severities").BodyJSON(&req).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
 		return nil, errors.Wrap(err, "could not create severity")
 	}
 
-	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		return nil, fmt.Errorf("Could not create severity %s", req.Slug)
-	}
-
 	return res, nil
 }
 
 // UpdateSeverity updates a severity in FireHydrant
 func (c *APIClient) UpdateSeverity(ctx context.Context, slug string, req UpdateSeverityRequest) (*SeverityResponse, error) {
 	res := &SeverityResponse{}
+	apiErr := &APIError{}
 
-	if _, err := c.client().Patch("severities/"+slug).BodyJSON(&req).Receive(res, nil); err != nil {
+	resp, err := c.client(ctx).Patch("severities/"+slug).BodyJSON(&req).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
 		return nil, errors.Wrap(err, "could not update severity")
 	}
 
@@ -346,8 +918,11 @@ func (c *APIClient) UpdateSeverity(ctx context.Context, slug string, req UpdateS
 
 // DeleteSeverity deletes a severity record from FireHydrant
 func (c *APIClient) DeleteSeverity(ctx context.Context, slug string) error {
-	if _, err := c.client().Delete("severities/"+slug).Receive(nil, nil); err != nil {
-		return errors.Wrap(err, "could not delete service")
+	apiErr := &APIError{}
+
+	resp, err := c.client(ctx).Delete("severities/"+slug).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not delete severity")
 	}
 
 	return nil
@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"testing"
 
 	"github.com/bxcodec/faker/v3"
@@ -127,3 +129,88 @@ func TestClientInitialization(t *testing.T) {
 		t.Fatalf("Expected %s, Got: %s for actor email", expected, actorEmail)
 	}
 }
+
+func TestWithBaseURL(t *testing.T) {
+	cases := map[string]struct {
+		baseURL  string
+		expected string
+		valid    bool
+	}{
+		"default":                {baseURL: DefaultBaseURL, expected: DefaultBaseURL, valid: true},
+		"missing trailing slash": {baseURL: "https://staging.firehydrant.io/v1", expected: "https://staging.firehydrant.io/v1/", valid: true},
+		"host only":              {baseURL: "http://localhost:8080", expected: "http://localhost:8080/", valid: true},
+		"relative":               {baseURL: "api.firehydrant.io/v1/"},
+		"unsupported scheme":     {baseURL: "ftp://api.firehydrant.io/v1/"},
+		"unparseable":            {baseURL: "https://api.firehydrant.io:port/v1/"},
+		"scheme without host":    {baseURL: "https:///v1/"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c, err := NewRestClient("testing-123", WithBaseURL(tc.baseURL))
+			if !tc.valid {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, c.baseURL)
+		})
+	}
+}
+
+func TestUserAgent(t *testing.T) {
+	var userAgent string
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		userAgent = req.Header.Get("User-Agent")
+
+		w.Write([]byte(pingResponseJSON))
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	c, err := NewRestClient("testing-123", WithBaseURL(ts.URL))
+	require.NoError(t, err)
+
+	_, err = c.Ping(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, "terraform-provider-firehydrant/"+Version, userAgent)
+
+	c, err = NewRestClient("testing-123", WithBaseURL(ts.URL), WithUserAgent(DefaultUserAgent()+" Terraform/1.0.0"))
+	require.NoError(t, err)
+
+	_, err = c.Ping(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, "terraform-provider-firehydrant/"+Version+" Terraform/1.0.0", userAgent)
+}
+
+func TestWithCACertFile(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(pingResponseJSON))
+	}))
+	defer ts.Close()
+
+	// Without the server's certificate the client does not trust it
+	c, err := NewRestClient("testing-123", WithBaseURL(ts.URL), WithRetries(0, 0))
+	require.NoError(t, err)
+
+	_, err = c.Ping(context.TODO())
+	assert.Error(t, err)
+
+	caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	require.NoError(t, ioutil.WriteFile(caCertFile, certPEM, 0600))
+
+	c, err = NewRestClient("testing-123", WithBaseURL(ts.URL), WithCACertFile(caCertFile))
+	require.NoError(t, err)
+
+	_, err = c.Ping(context.TODO())
+	assert.NoError(t, err)
+
+	notPEMFile := filepath.Join(t.TempDir(), "not-a-cert.pem")
+	require.NoError(t, ioutil.WriteFile(notPEMFile, []byte("not a certificate"), 0600))
+
+	_, err = NewRestClient("testing-123", WithCACertFile(notPEMFile))
+	assert.Error(t, err)
+}
@@ -0,0 +1,51 @@
+package firehydrant
+
+import (
+	"io"
+	"net/http"
+	"sync"
+)
+
+// concurrencyLimitTransport bounds how many requests are in flight at once. Requests over the limit
+// wait for a slot rather than failing, and a slot is held until the response body is closed so that
+// reading a large response still counts against the limit
+type concurrencyLimitTransport struct {
+	next  http.RoundTripper
+	slots chan struct{}
+}
+
+func newConcurrencyLimitTransport(next http.RoundTripper, limit int) *concurrencyLimitTransport {
+	return &concurrencyLimitTransport{
+		next:  next,
+		slots: make(chan struct{}, limit),
+	}
+}
+
+func (t *concurrencyLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case t.slots <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		<-t.slots
+		return nil, err
+	}
+
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: func() { <-t.slots }}
+	return resp, nil
+}
+
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+func (b *releaseOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
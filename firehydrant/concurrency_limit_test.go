@@ -0,0 +1,78 @@
+package firehydrant
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		w.Write([]byte(serviceResponseJSON))
+	}))
+	defer ts.Close()
+
+	c, err := NewRestClient("testing-123", WithBaseURL(ts.URL), WithMaxConcurrentRequests(2))
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.Services().Get(context.TODO(), "service-id")
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.NoError(t, err, "requests over the limit should wait rather than fail")
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&maxInFlight))
+}
+
+func TestMaxConcurrentRequestsStopWithTheirContext(t *testing.T) {
+	ts := slowServer(time.Second)
+	defer ts.Close()
+
+	c, err := NewRestClient("testing-123", WithBaseURL(ts.URL), WithMaxConcurrentRequests(1))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 50*time.Millisecond)
+	defer cancel()
+
+	go c.Services().Get(ctx, "first-service-id")
+	time.Sleep(10 * time.Millisecond)
+
+	_, err = c.Services().Get(ctx, "second-service-id")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "expected the queued request to time out, got %s", err)
+}
+
+func TestMaxConcurrentRequestsMustNotBeNegative(t *testing.T) {
+	_, err := NewRestClient("testing-123", WithMaxConcurrentRequests(-1))
+	assert.Error(t, err)
+}
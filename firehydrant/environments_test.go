@@ -6,7 +6,11 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"sync"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetEnvironment(t *testing.T) {
@@ -16,6 +20,7 @@ func TestGetEnvironment(t *testing.T) {
 		ID:          "test-id",
 		Name:        "test environment",
 		Description: "this environment causes people to forget to share their screen",
+		Labels:      map[string]string{"tier": "production"},
 	}
 
 	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
@@ -57,12 +62,14 @@ func TestCreateEnvironment(t *testing.T) {
 	req := CreateEnvironmentRequest{
 		Name:        "test environment",
 		Description: "this environment causes people to forget to share their screen",
+		Labels:      map[string]string{"region": "us-east-1"},
 	}
 
 	resp := EnvironmentResponse{
 		ID:          "test-id",
 		Name:        req.Name,
 		Description: req.Description,
+		Labels:      req.Labels,
 	}
 
 	var rcvdEnv EnvironmentResponse
@@ -103,3 +110,118 @@ func TestCreateEnvironment(t *testing.T) {
 		t.Fatalf("Expected %+v, Got: %+v for response", resp, res)
 	}
 }
+
+func TestListEnvironments(t *testing.T) {
+	var requestURIRcvd string
+
+	expectedEnvironments := EnvironmentsResponse{
+		Environments: []EnvironmentResponse{
+			{
+				ID:   "test-id",
+				Name: "production",
+				Slug: "production",
+			},
+		},
+	}
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requestURIRcvd = req.URL.RequestURI()
+
+		if err := json.NewEncoder(w).Encode(expectedEnvironments); err != nil {
+			panic(err)
+		}
+	})
+	ts := httptest.NewServer(h)
+
+	defer ts.Close()
+
+	testToken := "testing-123"
+	c, err := NewRestClient(testToken, WithBaseURL(ts.URL))
+
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+		return
+	}
+
+	res, err := c.ListEnvironments(context.TODO(), &EnvironmentQuery{Query: "production"})
+	if err != nil {
+		t.Fatalf("Received error hitting environment list endpoint: %s", err.Error())
+	}
+
+	if expected := "/environments?page=1&query=production"; expected != requestURIRcvd {
+		t.Fatalf("Expected %s, Got: %s for request path", expected, requestURIRcvd)
+	}
+
+	if !reflect.DeepEqual(&expectedEnvironments, res) {
+		t.Fatalf("Expected %+v, Got: %+v for response", expectedEnvironments, res)
+	}
+}
+
+func TestUpdateEnvironmentClearsLabels(t *testing.T) {
+	resp := &EnvironmentResponse{}
+	c, teardown, err := setupClient("/environments/test-id", resp,
+		AssertRequestJSONBody(t, map[string]interface{}{"labels": map[string]string{}}),
+		AssertRequestMethod(t, "PATCH"),
+	)
+
+	require.NoError(t, err)
+	defer teardown()
+
+	_, err = c.UpdateEnvironment(context.TODO(), "test-id", UpdateEnvironmentRequest{Labels: &map[string]string{}})
+	require.NoError(t, err, "error updating an environment")
+}
+
+func TestUpdateEnvironmentClearsDescription(t *testing.T) {
+	resp := &EnvironmentResponse{}
+	c, teardown, err := setupClient("/environments/test-id", resp,
+		AssertRequestJSONBody(t, map[string]interface{}{"description": ""}),
+		AssertRequestMethod(t, "PATCH"),
+	)
+
+	require.NoError(t, err)
+	defer teardown()
+
+	_, err = c.UpdateEnvironment(context.TODO(), "test-id", UpdateEnvironmentRequest{Description: String("")})
+	require.NoError(t, err, "error updating an environment")
+}
+
+func TestListEnvironmentsPaginated(t *testing.T) {
+	var requests []string
+	var mu sync.Mutex
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		requests = append(requests, req.URL.RawQuery)
+		mu.Unlock()
+
+		switch req.URL.Query().Get("page") {
+		case "1":
+			w.Write([]byte(`{"data": [{"id": "environment-1", "name": "prod-eu"}], "pagination": {"page": 1, "pages": 3}}`))
+		case "2":
+			w.Write([]byte(`{"data": [{"id": "environment-2", "name": "prod-us"}], "pagination": {"page": 2, "pages": 3}}`))
+		default:
+			w.Write([]byte(`{"data": [{"id": "environment-3", "name": "prod"}], "pagination": {"page": 3, "pages": 3}}`))
+		}
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	c, err := NewRestClient("testing-123", WithBaseURL(ts.URL))
+	require.NoError(t, err)
+
+	res, err := c.ListEnvironments(context.TODO(), &EnvironmentQuery{Query: "prod"})
+	require.NoError(t, err, "error listing environments")
+
+	// Pages after the first are fetched at the same time, so they can be requested in any order
+	assert.ElementsMatch(t, []string{"page=1&query=prod", "page=2&query=prod", "page=3&query=prod"}, requests)
+	require.Len(t, res.Environments, 3)
+	assert.Equal(t, "environment-3", res.Environments[2].ID, "environments past the first page should be included")
+
+	// Asking for a page only fetches that page
+	requests = nil
+	res, err = c.ListEnvironments(context.TODO(), &EnvironmentQuery{Query: "prod", Page: 2, PerPage: 1})
+	require.NoError(t, err, "error listing a page of environments")
+
+	assert.Equal(t, []string{"page=2&per_page=1&query=prod"}, requests)
+	require.Len(t, res.Environments, 1)
+}
@@ -0,0 +1,171 @@
+package firehydrant
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// NotFound is returned when a resource could not be found in FireHydrant
+type NotFound string
+
+func (nf NotFound) Error() string {
+	return string(nf)
+}
+
+// FieldError is a validation error FireHydrant reported for a single field
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// APIError is returned when FireHydrant responds with a non-2xx status code
+type APIError struct {
+	StatusCode int
+	// Body is the raw response body FireHydrant sent back
+	Body []byte
+
+	Message     string
+	Detail      string
+	Messages    []string
+	FieldErrors []FieldError
+
+	// RequestID is the ID FireHydrant gave the request, which FireHydrant support can look it up by
+	RequestID string
+	// Header is the response's headers, with the values of sensitive ones such as cookies redacted
+	Header http.Header
+}
+
+// requestIDHeader is the response header FireHydrant puts a request's ID in
+const requestIDHeader = "X-Request-Id"
+
+// sensitiveHeaders are response headers whose values are never kept in an APIError, along with any
+// header named like a secret field
+var sensitiveHeaders = []string{"Set-Cookie", "Authorization", "Proxy-Authorization"}
+
+// apiErrorEnvelope is the shape of the error body FireHydrant returns
+type apiErrorEnvelope struct {
+	Error    string       `json:"error"`
+	Detail   string       `json:"detail"`
+	Messages []string     `json:"messages"`
+	Errors   []FieldError `json:"errors"`
+}
+
+func (e *APIError) Error() string {
+	var details []string
+	if e.Message != "" {
+		details = append(details, e.Message)
+	}
+	if e.Detail != "" {
+		details = append(details, e.Detail)
+	}
+	details = append(details, e.Messages...)
+	for _, fe := range e.FieldErrors {
+		details = append(details, fmt.Sprintf("%s %s", fe.Field, fe.Message))
+	}
+
+	msg := fmt.Sprintf("FireHydrant API returned %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	if len(details) > 0 {
+		msg += ": " + strings.Join(details, "; ")
+	}
+	if e.RequestID != "" {
+		msg += fmt.Sprintf(" (request ID %s)", e.RequestID)
+	}
+
+	return msg
+}
+
+// setHeader keeps a response's headers for debugging, redacting the values of sensitive ones
+func (e *APIError) setHeader(header http.Header) {
+	e.RequestID = header.Get(requestIDHeader)
+
+	e.Header = http.Header{}
+	for name, values := range header {
+		if isSensitiveHeader(name) {
+			e.Header[name] = []string{redacted}
+			continue
+		}
+		e.Header[name] = append([]string(nil), values...)
+	}
+}
+
+func isSensitiveHeader(name string) bool {
+	for _, sensitive := range sensitiveHeaders {
+		if strings.EqualFold(name, sensitive) {
+			return true
+		}
+	}
+
+	return isSecretField(name)
+}
+
+// decode reads an error response, keeping the raw body and whatever could be parsed from it
+func (e *APIError) decode(resp *http.Response) error {
+	e.StatusCode = resp.StatusCode
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	e.Body = body
+
+	// Not every error response is JSON (such as those from a load balancer), so the raw body is all we have
+	envelope := apiErrorEnvelope{}
+	if err := json.Unmarshal(body, &envelope); err == nil {
+		e.Message = envelope.Error
+		e.Detail = envelope.Detail
+		e.Messages = envelope.Messages
+		e.FieldErrors = envelope.Errors
+	}
+
+	return nil
+}
+
+// IsNotFound reports whether err means the requested resource does not exist in FireHydrant
+func IsNotFound(err error) bool {
+	var nf NotFound
+	if errors.As(err, &nf) {
+		return true
+	}
+
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// IsConflict reports whether FireHydrant refused a request because it conflicts with something that
+// already exists, such as an on-call override that overlaps another
+func IsConflict(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict
+}
+
+// responseDecoder decodes JSON responses, reading failures into an *APIError without requiring a JSON body
+type responseDecoder struct{}
+
+func (responseDecoder) Decode(resp *http.Response, v interface{}) error {
+	if apiErr, ok := v.(*APIError); ok {
+		return apiErr.decode(resp)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// checkResponse turns the result of a request into an error. Failures sending the request or
+// decoding the response are returned as-is, and non-2xx responses are returned as an *APIError
+func checkResponse(resp *http.Response, err error, apiErr *APIError) error {
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		// Responses without a body are never decoded, so the status and headers are kept here
+		apiErr.StatusCode = resp.StatusCode
+		apiErr.setHeader(resp.Header)
+		return apiErr
+	}
+
+	return nil
+}
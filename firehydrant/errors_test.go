@@ -0,0 +1,101 @@
+package firehydrant
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupErrorClient(t *testing.T, status int, body string) (*APIClient, func()) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	})
+	ts := httptest.NewServer(h)
+
+	c, err := NewRestClient("testing-123", WithBaseURL(ts.URL), WithRetries(0, 0))
+	require.NoError(t, err)
+
+	return c, ts.Close
+}
+
+func TestAPIErrorValidationFailure(t *testing.T) {
+	body := `{"error":"Invalid request","errors":[{"field":"name","message":"can't be blank"}]}`
+	c, teardown := setupErrorClient(t, http.StatusUnprocessableEntity, body)
+	defer teardown()
+
+	_, err := c.Services().Create(context.TODO(), CreateServiceRequest{})
+	require.Error(t, err)
+
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr), "expected an *APIError, got %T", err)
+	assert.Equal(t, http.StatusUnprocessableEntity, apiErr.StatusCode)
+	assert.Equal(t, body, string(apiErr.Body))
+	assert.Equal(t, "Invalid request", apiErr.Message)
+	assert.Equal(t, []FieldError{{Field: "name", Message: "can't be blank"}}, apiErr.FieldErrors)
+	assert.Contains(t, err.Error(), "name can't be blank")
+	assert.False(t, IsNotFound(err))
+}
+
+func TestAPIErrorNotFound(t *testing.T) {
+	c, teardown := setupErrorClient(t, http.StatusNotFound, `{"error":"Record not found"}`)
+	defer teardown()
+
+	res, err := c.Services().Get(context.TODO(), "service-id")
+	assert.Nil(t, res)
+	assert.True(t, IsNotFound(err), "expected a not found error, got %v", err)
+}
+
+func TestAPIErrorNonJSONBody(t *testing.T) {
+	body := "<html><body>502 Bad Gateway</body></html>"
+	c, teardown := setupErrorClient(t, http.StatusBadGateway, body)
+	defer teardown()
+
+	_, err := c.Services().Get(context.TODO(), "service-id")
+	require.Error(t, err)
+
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr), "expected an *APIError, got %T", err)
+	assert.Equal(t, http.StatusBadGateway, apiErr.StatusCode)
+	assert.Equal(t, body, string(apiErr.Body))
+	assert.Empty(t, apiErr.Message)
+}
+
+func TestAPIErrorConflict(t *testing.T) {
+	c, teardown := setupErrorClient(t, http.StatusConflict, `{"detail":"Override overlaps an existing override"}`)
+	defer teardown()
+
+	_, err := c.OnCallOverrides().Create(context.TODO(), "team-id", "schedule-id", CreateOnCallOverrideRequest{})
+	assert.True(t, IsConflict(err), "expected a conflict error, got %v", err)
+	assert.False(t, IsNotFound(err))
+	assert.Contains(t, err.Error(), "Override overlaps an existing override")
+}
+
+func TestAPIErrorResponseHeaders(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("X-Request-Id", "test-request-id")
+		w.Header().Set("Set-Cookie", "session=test-session")
+		w.Header().Set("X-Runtime", "0.012")
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	c, err := NewRestClient("testing-123", WithBaseURL(ts.URL), WithRetries(0, 0))
+	require.NoError(t, err)
+
+	_, err = c.Services().Get(context.TODO(), "service-id")
+	require.Error(t, err)
+
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr), "expected an *APIError, got %T", err)
+	assert.Equal(t, "test-request-id", apiErr.RequestID)
+	assert.Contains(t, err.Error(), "request ID test-request-id")
+	assert.Equal(t, "0.012", apiErr.Header.Get("X-Runtime"))
+	assert.Equal(t, redacted, apiErr.Header.Get("Set-Cookie"), "cookies should be redacted")
+}
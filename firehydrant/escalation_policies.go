@@ -0,0 +1,125 @@
+package firehydrant
+
+import (
+	"context"
+	"time"
+
+	"github.com/dghubble/sling"
+	"github.com/pkg/errors"
+)
+
+// EscalationPolicyTarget is who gets notified by an escalation policy step
+type EscalationPolicyTarget struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// EscalationPolicyStep is a single step of an escalation policy
+type EscalationPolicyStep struct {
+	Timeout string                   `json:"timeout"`
+	Targets []EscalationPolicyTarget `json:"targets"`
+}
+
+// EscalationPolicyResponse is the payload for retrieving an escalation policy
+// URL: GET https://api.firehydrant.io/v1/teams/{team_id}/escalation_policies/{id}
+type EscalationPolicyResponse struct {
+	ID          string                 `json:"id"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Repetitions int                    `json:"repetitions"`
+	Steps       []EscalationPolicyStep `json:"steps"`
+	CreatedAt   time.Time              `json:"created_at"`
+	UpdatedAt   time.Time              `json:"updated_at"`
+}
+
+// CreateEscalationPolicyRequest is the payload for creating an escalation policy
+// URL: POST https://api.firehydrant.io/v1/teams/{team_id}/escalation_policies
+type CreateEscalationPolicyRequest struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Repetitions int                    `json:"repetitions"`
+	Steps       []EscalationPolicyStep `json:"steps"`
+}
+
+// UpdateEscalationPolicyRequest is the payload for updating an escalation policy
+// URL: PATCH https://api.firehydrant.io/v1/teams/{team_id}/escalation_policies/{id}
+type UpdateEscalationPolicyRequest struct {
+	Name        string                 `json:"name,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Repetitions int                    `json:"repetitions"`
+	Steps       []EscalationPolicyStep `json:"steps"`
+}
+
+// EscalationPoliciesClient is an interface for interacting with escalation policies on FireHydrant
+type EscalationPoliciesClient interface {
+	Get(ctx context.Context, teamID, id string) (*EscalationPolicyResponse, error)
+	Create(ctx context.Context, teamID string, createReq CreateEscalationPolicyRequest) (*EscalationPolicyResponse, error)
+	Update(ctx context.Context, teamID, id string, updateReq UpdateEscalationPolicyRequest) (*EscalationPolicyResponse, error)
+	Delete(ctx context.Context, teamID, id string) error
+}
+
+// RESTEscalationPoliciesClient implements the EscalationPoliciesClient interface
+type RESTEscalationPoliciesClient struct {
+	client *APIClient
+}
+
+var _ EscalationPoliciesClient = &RESTEscalationPoliciesClient{}
+
+func (c *RESTEscalationPoliciesClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
+}
+
+func escalationPolicyPath(teamID string) string {
+	return "teams/" + teamID + "/escalation_policies"
+}
+
+// Get returns an escalation policy from the FireHydrant API
+func (c *RESTEscalationPoliciesClient) Get(ctx context.Context, teamID, id string) (*EscalationPolicyResponse, error) {
+	res := &EscalationPolicyResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get(escalationPolicyPath(teamID)+"/"+id).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get escalation policy")
+	}
+
+	return res, nil
+}
+
+// Create creates an escalation policy for a team in FireHydrant
+func (c *RESTEscalationPoliciesClient) Create(ctx context.Context, teamID string, createReq CreateEscalationPolicyRequest) (*EscalationPolicyResponse, error) {
+	res := &EscalationPolicyResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Post(escalationPolicyPath(teamID)).BodyJSON(&createReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not create escalation policy")
+	}
+
+	return res, nil
+}
+
+// Update updates an escalation policy in FireHydrant
+func (c *RESTEscalationPoliciesClient) Update(ctx context.Context, teamID, id string, updateReq UpdateEscalationPolicyRequest) (*EscalationPolicyResponse, error) {
+	res := &EscalationPolicyResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Patch(escalationPolicyPath(teamID)+"/"+id).BodyJSON(&updateReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not update escalation policy")
+	}
+
+	return res, nil
+}
+
+// Delete deletes an escalation policy from FireHydrant
+func (c *RESTEscalationPoliciesClient) Delete(ctx context.Context, teamID, id string) error {
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Delete(escalationPolicyPath(teamID)+"/"+id).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not delete escalation policy")
+	}
+
+	return nil
+}
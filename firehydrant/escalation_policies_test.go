@@ -0,0 +1,46 @@
+package firehydrant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateEscalationPolicy(t *testing.T) {
+	req := CreateEscalationPolicyRequest{
+		Name:        "fake-policy",
+		Repetitions: 2,
+		Steps: []EscalationPolicyStep{
+			{
+				Timeout: "PT5M",
+				Targets: []EscalationPolicyTarget{{Type: "schedule", ID: "schedule-id"}},
+			},
+		},
+	}
+
+	resp := &EscalationPolicyResponse{}
+	c, teardown, err := setupClient("/teams/team-id/escalation_policies", resp,
+		AssertRequestJSONBody(t, req),
+		AssertRequestMethod(t, "POST"),
+	)
+	require.NoError(t, err)
+	defer teardown()
+
+	_, err = c.EscalationPolicies().Create(context.TODO(), "team-id", req)
+	require.NoError(t, err, "error creating an escalation policy")
+}
+
+func TestGetEscalationPolicy(t *testing.T) {
+	resp := &EscalationPolicyResponse{}
+	c, teardown, err := setupClient("/teams/team-id/escalation_policies/policy-id", resp,
+		AssertRequestMethod(t, "GET"),
+	)
+	require.NoError(t, err)
+	defer teardown()
+
+	res, err := c.EscalationPolicies().Get(context.TODO(), "team-id", "policy-id")
+	require.NoError(t, err, "error retrieving an escalation policy")
+	assert.Equal(t, resp.ID, res.ID, "returned escalation policy did not match")
+}
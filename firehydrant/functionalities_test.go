@@ -0,0 +1,53 @@
+package firehydrant
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListFunctionalitiesPaginated(t *testing.T) {
+	var requests []string
+	var mu sync.Mutex
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		requests = append(requests, req.URL.RawQuery)
+		mu.Unlock()
+
+		switch req.URL.Query().Get("page") {
+		case "1":
+			w.Write([]byte(`{"data": [{"id": "functionality-1", "name": "Checkout"}], "pagination": {"page": 1, "pages": 3}}`))
+		case "2":
+			w.Write([]byte(`{"data": [{"id": "functionality-2", "name": "Checkout Reporting"}], "pagination": {"page": 2, "pages": 3}}`))
+		default:
+			w.Write([]byte(`{"data": [{"id": "functionality-3", "name": "Checkout"}], "pagination": {"page": 3, "pages": 3}}`))
+		}
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	c, err := NewRestClient("testing-123", WithBaseURL(ts.URL))
+	require.NoError(t, err)
+
+	res, err := c.ListFunctionalities(context.TODO(), &FunctionalityQuery{Query: "Checkout"})
+	require.NoError(t, err, "error listing functionalities")
+
+	// Pages after the first are fetched at the same time, so they can be requested in any order
+	assert.ElementsMatch(t, []string{"page=1&query=Checkout", "page=2&query=Checkout", "page=3&query=Checkout"}, requests)
+	require.Len(t, res.Functionalities, 3)
+	assert.Equal(t, "functionality-3", res.Functionalities[2].ID, "functionalities past the first page should be included")
+
+	// Asking for a page only fetches that page
+	requests = nil
+	res, err = c.ListFunctionalities(context.TODO(), &FunctionalityQuery{Query: "Checkout", Page: 2, PerPage: 1})
+	require.NoError(t, err, "error listing a page of functionalities")
+
+	assert.Equal(t, []string{"page=2&per_page=1&query=Checkout"}, requests)
+	require.Len(t, res.Functionalities, 1)
+}
@@ -0,0 +1,137 @@
+package firehydrant
+
+import (
+	"context"
+	"time"
+
+	"github.com/dghubble/sling"
+	"github.com/pkg/errors"
+)
+
+// IncidentRoleResponse is the payload for retrieving an incident role
+// URL: GET https://api.firehydrant.io/v1/incident_roles/{id}
+type IncidentRoleResponse struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Summary     string `json:"summary"`
+	Description string `json:"description"`
+
+	// DiscardedAt is set once a role has been deleted. FireHydrant keeps deleted roles
+	// around (and retrievable) so that historical incidents can still reference them
+	DiscardedAt *time.Time `json:"discarded_at"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// IncidentRolesResponse is the payload for retrieving a list of incident roles
+// URL: GET https://api.firehydrant.io/v1/incident_roles
+type IncidentRolesResponse struct {
+	IncidentRoles []IncidentRoleResponse `json:"data"`
+	Pagination    *Pagination            `json:"pagination,omitempty"`
+}
+
+// IncidentRoleQuery is the query used to search for incident roles
+type IncidentRoleQuery struct {
+	Query string `url:"query,omitempty"`
+}
+
+// CreateIncidentRoleRequest is the payload for creating an incident role
+// URL: POST https://api.firehydrant.io/v1/incident_roles
+type CreateIncidentRoleRequest struct {
+	Name        string `json:"name"`
+	Summary     string `json:"summary"`
+	Description string `json:"description,omitempty"`
+}
+
+// UpdateIncidentRoleRequest is the payload for updating an incident role
+// URL: PATCH https://api.firehydrant.io/v1/incident_roles/{id}
+type UpdateIncidentRoleRequest struct {
+	Name        string `json:"name,omitempty"`
+	Summary     string `json:"summary,omitempty"`
+	Description string `json:"description"`
+}
+
+// IncidentRolesClient is an interface for interacting with incident roles on FireHydrant
+type IncidentRolesClient interface {
+	Get(ctx context.Context, id string) (*IncidentRoleResponse, error)
+	List(ctx context.Context, req *IncidentRoleQuery) (*IncidentRolesResponse, error)
+	Create(ctx context.Context, createReq CreateIncidentRoleRequest) (*IncidentRoleResponse, error)
+	Update(ctx context.Context, id string, updateReq UpdateIncidentRoleRequest) (*IncidentRoleResponse, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// RESTIncidentRolesClient implements the IncidentRolesClient interface
+type RESTIncidentRolesClient struct {
+	client *APIClient
+}
+
+var _ IncidentRolesClient = &RESTIncidentRolesClient{}
+
+func (c *RESTIncidentRolesClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
+}
+
+// Get returns an incident role from the FireHydrant API
+func (c *RESTIncidentRolesClient) Get(ctx context.Context, id string) (*IncidentRoleResponse, error) {
+	res := &IncidentRoleResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get("incident_roles/"+id).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get incident role")
+	}
+
+	return res, nil
+}
+
+// List retrieves the incident roles matching a query
+func (c *RESTIncidentRolesClient) List(ctx context.Context, req *IncidentRoleQuery) (*IncidentRolesResponse, error) {
+	res := &IncidentRolesResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get("incident_roles").QueryStruct(req).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get incident roles")
+	}
+
+	return res, nil
+}
+
+// Create creates an incident role in FireHydrant
+func (c *RESTIncidentRolesClient) Create(ctx context.Context, createReq CreateIncidentRoleRequest) (*IncidentRoleResponse, error) {
+	res := &IncidentRoleResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Post("incident_roles").BodyJSON(&createReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not create incident role")
+	}
+
+	return res, nil
+}
+
+// Update updates an incident role in FireHydrant
+func (c *RESTIncidentRolesClient) Update(ctx context.Context, id string, updateReq UpdateIncidentRoleRequest) (*IncidentRoleResponse, error) {
+	res := &IncidentRoleResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Patch("incident_roles/"+id).BodyJSON(&updateReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not update incident role")
+	}
+
+	return res, nil
+}
+
+// Delete archives an incident role in FireHydrant
+func (c *RESTIncidentRolesClient) Delete(ctx context.Context, id string) error {
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Delete("incident_roles/"+id).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not delete incident role")
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package firehydrant
+
+import (
+	"context"
+	"time"
+
+	"github.com/dghubble/sling"
+	"github.com/pkg/errors"
+)
+
+// IncidentTypeTemplate is what an incident of a given type starts out with
+type IncidentTypeTemplate struct {
+	Description string            `json:"description"`
+	Severity    string            `json:"severity"`
+	Priority    string            `json:"priority"`
+	Labels      map[string]string `json:"labels"`
+}
+
+// IncidentTypeResponse is the payload for retrieving an incident type
+// URL: GET https://api.firehydrant.io/v1/incident_types/{id}
+type IncidentTypeResponse struct {
+	ID        string               `json:"id"`
+	Name      string               `json:"name"`
+	Template  IncidentTypeTemplate `json:"template"`
+	CreatedAt time.Time            `json:"created_at"`
+	UpdatedAt time.Time            `json:"updated_at"`
+}
+
+// IncidentTypesResponse is the payload for retrieving a list of incident types
+// URL: GET https://api.firehydrant.io/v1/incident_types
+type IncidentTypesResponse struct {
+	IncidentTypes []IncidentTypeResponse `json:"data"`
+	Pagination    *Pagination            `json:"pagination,omitempty"`
+}
+
+// IncidentTypeQuery is the query used to search for incident types
+type IncidentTypeQuery struct {
+	Query string `url:"query,omitempty"`
+}
+
+// CreateIncidentTypeRequest is the payload for creating an incident type
+// URL: POST https://api.firehydrant.io/v1/incident_types
+type CreateIncidentTypeRequest struct {
+	Name     string               `json:"name"`
+	Template IncidentTypeTemplate `json:"template"`
+}
+
+// UpdateIncidentTypeRequest is the payload for updating an incident type
+// URL: PATCH https://api.firehydrant.io/v1/incident_types/{id}
+type UpdateIncidentTypeRequest struct {
+	Name     string               `json:"name,omitempty"`
+	Template IncidentTypeTemplate `json:"template"`
+}
+
+// IncidentTypesClient is an interface for interacting with incident types on FireHydrant
+type IncidentTypesClient interface {
+	Get(ctx context.Context, id string) (*IncidentTypeResponse, error)
+	List(ctx context.Context, req *IncidentTypeQuery) (*IncidentTypesResponse, error)
+	Create(ctx context.Context, createReq CreateIncidentTypeRequest) (*IncidentTypeResponse, error)
+	Update(ctx context.Context, id string, updateReq UpdateIncidentTypeRequest) (*IncidentTypeResponse, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// RESTIncidentTypesClient implements the IncidentTypesClient interface
+type RESTIncidentTypesClient struct {
+	client *APIClient
+}
+
+var _ IncidentTypesClient = &RESTIncidentTypesClient{}
+
+func (c *RESTIncidentTypesClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
+}
+
+// Get returns an incident type from the FireHydrant API
+func (c *RESTIncidentTypesClient) Get(ctx context.Context, id string) (*IncidentTypeResponse, error) {
+	res := &IncidentTypeResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get("incident_types/"+id).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get incident type")
+	}
+
+	return res, nil
+}
+
+// List retrieves the incident types matching a query
+func (c *RESTIncidentTypesClient) List(ctx context.Context, req *IncidentTypeQuery) (*IncidentTypesResponse, error) {
+	res := &IncidentTypesResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get("incident_types").QueryStruct(req).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get incident types")
+	}
+
+	return res, nil
+}
+
+// Create creates an incident type in FireHydrant
+func (c *RESTIncidentTypesClient) Create(ctx context.Context, createReq CreateIncidentTypeRequest) (*IncidentTypeResponse, error) {
+	res := &IncidentTypeResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Post("incident_types").BodyJSON(&createReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not create incident type")
+	}
+
+	return res, nil
+}
+
+// Update updates an incident type in FireHydrant
+func (c *RESTIncidentTypesClient) Update(ctx context.Context, id string, updateReq UpdateIncidentTypeRequest) (*IncidentTypeResponse, error) {
+	res := &IncidentTypeResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Patch("incident_types/"+id).BodyJSON(&updateReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not update incident type")
+	}
+
+	return res, nil
+}
+
+// Delete archives an incident type in FireHydrant
+func (c *RESTIncidentTypesClient) Delete(ctx context.Context, id string) error {
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Delete("incident_types/"+id).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not delete incident type")
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package firehydrant
+
+import (
+	"context"
+	"time"
+
+	"github.com/dghubble/sling"
+	"github.com/pkg/errors"
+)
+
+// IncidentResponse is the payload for an incident
+// URL: GET https://api.firehydrant.io/v1/incidents/{id}
+type IncidentResponse struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Severity string `json:"severity"`
+
+	// CurrentMilestone is how far along the incident is, such as started, mitigated, or resolved
+	CurrentMilestone string `json:"current_milestone"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// IncidentsResponse is the payload for listing incidents
+// URL: GET https://api.firehydrant.io/v1/incidents
+type IncidentsResponse struct {
+	Incidents  []IncidentResponse `json:"data"`
+	Pagination *Pagination        `json:"pagination,omitempty"`
+}
+
+// IncidentQuery is the query used to search for incidents. Filters that are empty are left out
+type IncidentQuery struct {
+	// Services and Environments are the IDs of a service or environment involved in the incidents
+	Services     string `url:"services,omitempty"`
+	Environments string `url:"environments,omitempty"`
+
+	// Status is either open or closed
+	Status string `url:"status,omitempty"`
+
+	Page    int `url:"page,omitempty"`
+	PerPage int `url:"per_page,omitempty"`
+}
+
+// IncidentsClient is an interface for interacting with incidents on FireHydrant
+type IncidentsClient interface {
+	List(ctx context.Context, req *IncidentQuery) (*IncidentsResponse, error)
+}
+
+// RESTIncidentsClient implements the IncidentsClient interface
+type RESTIncidentsClient struct {
+	client *APIClient
+}
+
+var _ IncidentsClient = &RESTIncidentsClient{}
+
+func (c *RESTIncidentsClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
+}
+
+// List retrieves the incidents matching a query. If the query does not request a specific page,
+// every page is fetched and the incidents are combined in page order
+func (c *RESTIncidentsClient) List(ctx context.Context, req *IncidentQuery) (*IncidentsResponse, error) {
+	if req == nil {
+		req = &IncidentQuery{}
+	}
+
+	if req.Page != 0 {
+		return c.listPage(ctx, req)
+	}
+
+	firstReq := *req
+	firstReq.Page = 1
+	res, err := c.listPage(ctx, &firstReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Pagination == nil || res.Pagination.TotalPages <= 1 {
+		return res, nil
+	}
+
+	pages := make([]*IncidentsResponse, res.Pagination.TotalPages+1)
+	err = fetchRemainingPages(ctx, res.Pagination.TotalPages, c.client.pageWorkers(), func(ctx context.Context, number int) error {
+		pageReq := *req
+		pageReq.Page = number
+		page, err := c.listPage(ctx, &pageReq)
+		pages[number] = page
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, page := range pages[2:] {
+		res.Incidents = append(res.Incidents, page.Incidents...)
+	}
+
+	return res, nil
+}
+
+func (c *RESTIncidentsClient) listPage(ctx context.Context, req *IncidentQuery) (*IncidentsResponse, error) {
+	res := &IncidentsResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get("incidents").QueryStruct(req).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get incidents")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,143 @@
+package firehydrant
+
+import (
+	"context"
+
+	"github.com/dghubble/sling"
+	"github.com/pkg/errors"
+)
+
+// ConnectionResponse is a connection to an integration, such as a PagerDuty account, configured in FireHydrant
+type ConnectionResponse struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	IntegrationSlug string `json:"integration_slug"`
+	IntegrationName string `json:"integration_name"`
+}
+
+// ConnectionsResponse is the payload for retrieving a list of integration connections
+// URL: GET https://api.firehydrant.io/v1/integrations/connections
+type ConnectionsResponse struct {
+	Connections []ConnectionResponse `json:"data"`
+	Pagination  *Pagination          `json:"pagination,omitempty"`
+}
+
+// ConnectionQuery is the query used to page through integration connections
+type ConnectionQuery struct {
+	Page int `url:"page,omitempty"`
+}
+
+// TicketingConfigResponse is how incidents are pushed as tickets through a connection, such as to Jira
+// URL: GET https://api.firehydrant.io/v1/integrations/connections/{id}/ticketing_config
+type TicketingConfigResponse struct {
+	ProjectKey    string            `json:"project_key"`
+	IssueType     string            `json:"issue_type"`
+	FieldMappings map[string]string `json:"field_mappings"`
+}
+
+// UpdateTicketingConfigRequest is the payload for configuring how incidents are pushed as tickets
+// URL: PUT https://api.firehydrant.io/v1/integrations/connections/{id}/ticketing_config
+type UpdateTicketingConfigRequest struct {
+	ProjectKey    string            `json:"project_key"`
+	IssueType     string            `json:"issue_type"`
+	FieldMappings map[string]string `json:"field_mappings"`
+	APIToken      string            `json:"api_token,omitempty"`
+}
+
+// IntegrationsClient is an interface for interacting with integrations on FireHydrant
+type IntegrationsClient interface {
+	ListConnections(ctx context.Context) (*ConnectionsResponse, error)
+	GetTicketingConfig(ctx context.Context, connectionID string) (*TicketingConfigResponse, error)
+	UpdateTicketingConfig(ctx context.Context, connectionID string, updateReq UpdateTicketingConfigRequest) (*TicketingConfigResponse, error)
+	DeleteTicketingConfig(ctx context.Context, connectionID string) error
+}
+
+// RESTIntegrationsClient implements the IntegrationsClient interface
+type RESTIntegrationsClient struct {
+	client *APIClient
+}
+
+var _ IntegrationsClient = &RESTIntegrationsClient{}
+
+func (c *RESTIntegrationsClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
+}
+
+// ListConnections retrieves every integration connection configured in FireHydrant, following pagination
+func (c *RESTIntegrationsClient) ListConnections(ctx context.Context) (*ConnectionsResponse, error) {
+	res, err := c.listConnectionsPage(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Pagination == nil || res.Pagination.TotalPages <= 1 {
+		return res, nil
+	}
+
+	pages := make([]*ConnectionsResponse, res.Pagination.TotalPages+1)
+	err = fetchRemainingPages(ctx, res.Pagination.TotalPages, c.client.pageWorkers(), func(ctx context.Context, number int) error {
+		page, err := c.listConnectionsPage(ctx, number)
+		pages[number] = page
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, page := range pages[2:] {
+		res.Connections = append(res.Connections, page.Connections...)
+	}
+
+	return res, nil
+}
+
+func (c *RESTIntegrationsClient) listConnectionsPage(ctx context.Context, number int) (*ConnectionsResponse, error) {
+	res := &ConnectionsResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get("integrations/connections").QueryStruct(&ConnectionQuery{Page: number}).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get integration connections")
+	}
+
+	return res, nil
+}
+
+// GetTicketingConfig returns how incidents are pushed as tickets through a connection
+func (c *RESTIntegrationsClient) GetTicketingConfig(ctx context.Context, connectionID string) (*TicketingConfigResponse, error) {
+	res := &TicketingConfigResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get("integrations/connections/"+connectionID+"/ticketing_config").Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get ticketing config")
+	}
+
+	return res, nil
+}
+
+// UpdateTicketingConfig replaces how incidents are pushed as tickets through a connection
+func (c *RESTIntegrationsClient) UpdateTicketingConfig(ctx context.Context, connectionID string, updateReq UpdateTicketingConfigRequest) (*TicketingConfigResponse, error) {
+	res := &TicketingConfigResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Put("integrations/connections/"+connectionID+"/ticketing_config").BodyJSON(&updateReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not update ticketing config")
+	}
+
+	return res, nil
+}
+
+// DeleteTicketingConfig stops incidents being pushed as tickets through a connection
+// URL: DELETE https://api.firehydrant.io/v1/integrations/connections/{id}/ticketing_config
+func (c *RESTIntegrationsClient) DeleteTicketingConfig(ctx context.Context, connectionID string) error {
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Delete("integrations/connections/"+connectionID+"/ticketing_config").Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not delete ticketing config")
+	}
+
+	return nil
+}
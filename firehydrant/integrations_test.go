@@ -0,0 +1,44 @@
+package firehydrant
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListConnections(t *testing.T) {
+	var pagesRequested []string
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/integrations/connections", req.URL.Path)
+
+		page := req.URL.Query().Get("page")
+		pagesRequested = append(pagesRequested, page)
+
+		response := `{"data": [{"id": "pd-connection", "name": "PagerDuty (production)", "integration_slug": "pager_duty"}], "pagination": {"pages": 2}}`
+		if page == "2" {
+			response = `{"data": [{"id": "og-connection", "name": "Opsgenie", "integration_slug": "opsgenie"}], "pagination": {"pages": 2}}`
+		}
+
+		w.Write([]byte(response))
+	})
+	ts := httptest.NewServer(h)
+
+	defer ts.Close()
+
+	c, err := NewRestClient("testing-123", WithBaseURL(ts.URL))
+	require.NoError(t, err)
+
+	res, err := c.Integrations().ListConnections(context.TODO())
+	require.NoError(t, err, "error listing integration connections")
+
+	assert.Equal(t, []string{"1", "2"}, pagesRequested)
+	assert.Equal(t, []ConnectionResponse{
+		{ID: "pd-connection", Name: "PagerDuty (production)", IntegrationSlug: "pager_duty"},
+		{ID: "og-connection", Name: "Opsgenie", IntegrationSlug: "opsgenie"},
+	}, res.Connections)
+}
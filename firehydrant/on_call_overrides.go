@@ -0,0 +1,108 @@
+package firehydrant
+
+import (
+	"context"
+	"time"
+
+	"github.com/dghubble/sling"
+	"github.com/pkg/errors"
+)
+
+// OnCallOverrideResponse is the payload for retrieving an override of an on-call schedule
+// URL: GET https://api.firehydrant.io/v1/teams/{team_id}/on_call_schedules/{schedule_id}/overrides/{id}
+type OnCallOverrideResponse struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+}
+
+// CreateOnCallOverrideRequest is the payload for overriding who is on call for part of a schedule
+// URL: POST https://api.firehydrant.io/v1/teams/{team_id}/on_call_schedules/{schedule_id}/overrides
+type CreateOnCallOverrideRequest struct {
+	UserID    string    `json:"user_id"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+}
+
+// UpdateOnCallOverrideRequest is the payload for updating an override of an on-call schedule
+// URL: PATCH https://api.firehydrant.io/v1/teams/{team_id}/on_call_schedules/{schedule_id}/overrides/{id}
+type UpdateOnCallOverrideRequest struct {
+	UserID    string    `json:"user_id,omitempty"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+}
+
+// OnCallOverridesClient is an interface for interacting with overrides of on-call schedules on FireHydrant
+type OnCallOverridesClient interface {
+	Get(ctx context.Context, teamID, scheduleID, id string) (*OnCallOverrideResponse, error)
+	Create(ctx context.Context, teamID, scheduleID string, createReq CreateOnCallOverrideRequest) (*OnCallOverrideResponse, error)
+	Update(ctx context.Context, teamID, scheduleID, id string, updateReq UpdateOnCallOverrideRequest) (*OnCallOverrideResponse, error)
+	Delete(ctx context.Context, teamID, scheduleID, id string) error
+}
+
+// RESTOnCallOverridesClient implements the OnCallOverridesClient interface
+type RESTOnCallOverridesClient struct {
+	client *APIClient
+}
+
+var _ OnCallOverridesClient = &RESTOnCallOverridesClient{}
+
+func (c *RESTOnCallOverridesClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
+}
+
+func onCallOverridePath(teamID, scheduleID string) string {
+	return schedulePath(teamID) + "/" + scheduleID + "/overrides"
+}
+
+// Get returns an override of an on-call schedule from the FireHydrant API
+func (c *RESTOnCallOverridesClient) Get(ctx context.Context, teamID, scheduleID, id string) (*OnCallOverrideResponse, error) {
+	res := &OnCallOverrideResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get(onCallOverridePath(teamID, scheduleID)+"/"+id).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get on-call override")
+	}
+
+	return res, nil
+}
+
+// Create overrides who is on call for part of an on-call schedule in FireHydrant
+func (c *RESTOnCallOverridesClient) Create(ctx context.Context, teamID, scheduleID string, createReq CreateOnCallOverrideRequest) (*OnCallOverrideResponse, error) {
+	res := &OnCallOverrideResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Post(onCallOverridePath(teamID, scheduleID)).BodyJSON(&createReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not create on-call override")
+	}
+
+	return res, nil
+}
+
+// Update updates an override of an on-call schedule in FireHydrant
+func (c *RESTOnCallOverridesClient) Update(ctx context.Context, teamID, scheduleID, id string, updateReq UpdateOnCallOverrideRequest) (*OnCallOverrideResponse, error) {
+	res := &OnCallOverrideResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Patch(onCallOverridePath(teamID, scheduleID)+"/"+id).BodyJSON(&updateReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not update on-call override")
+	}
+
+	return res, nil
+}
+
+// Delete deletes an override of an on-call schedule from FireHydrant
+func (c *RESTOnCallOverridesClient) Delete(ctx context.Context, teamID, scheduleID, id string) error {
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Delete(onCallOverridePath(teamID, scheduleID)+"/"+id).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not delete on-call override")
+	}
+
+	return nil
+}
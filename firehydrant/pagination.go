@@ -0,0 +1,70 @@
+package firehydrant
+
+import (
+	"context"
+	"sync"
+)
+
+// defaultPageWorkers is how many pages of a list are fetched at once. It's kept low since every page
+// counts against FireHydrant's rate limit
+const defaultPageWorkers = 5
+
+// pageWorkers is how many pages of a list to fetch at once, which is never more than the client's
+// concurrency limit so that workers are not left waiting for a slot
+func (c *APIClient) pageWorkers() int {
+	if c.maxConcurrent > 0 && c.maxConcurrent < defaultPageWorkers {
+		return c.maxConcurrent
+	}
+
+	return defaultPageWorkers
+}
+
+// fetchRemainingPages fetches pages 2 through totalPages of a list, once the first page has said how
+// many there are. Up to workers pages are fetched at once, so fetch must keep each page by its number
+// for the caller to combine them in page order, no matter which finished first. Once a page fails no
+// more are started, and the error from the earliest failed page is returned
+func fetchRemainingPages(ctx context.Context, totalPages, workers int, fetch func(ctx context.Context, page int) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pages := make(chan int)
+	errs := make([]error, totalPages+1)
+	var wg sync.WaitGroup
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for page := range pages {
+				// A page can be handed out just as another fails, so check before starting it
+				if ctx.Err() != nil {
+					continue
+				}
+
+				if err := fetch(ctx, page); err != nil {
+					errs[page] = err
+					cancel()
+				}
+			}
+		}()
+	}
+
+send:
+	for page := 2; page <= totalPages; page++ {
+		select {
+		case pages <- page:
+		case <-ctx.Done():
+			break send
+		}
+	}
+	close(pages)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return ctx.Err()
+}
@@ -0,0 +1,93 @@
+package firehydrant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListServicesFetchesPagesConcurrently(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+
+		page := req.URL.Query().Get("page")
+		// Earlier pages finish last, so the services are only in order if they're combined by page
+		if page == "2" {
+			time.Sleep(50 * time.Millisecond)
+		} else if page != "1" {
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		w.Write([]byte(fmt.Sprintf(`{"data": [{"id": "service-%s"}], "pagination": {"page": %s, "pages": 6}}`, page, page)))
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	c, err := NewRestClient("testing-123", WithBaseURL(ts.URL), WithMaxConcurrentRequests(2))
+	require.NoError(t, err)
+
+	res, err := c.Services().List(context.TODO(), &ServiceQuery{})
+	require.NoError(t, err, "error listing services")
+
+	ids := make([]string, len(res.Services))
+	for index, service := range res.Services {
+		ids[index] = service.ID
+	}
+
+	assert.Equal(t, []string{"service-1", "service-2", "service-3", "service-4", "service-5", "service-6"}, ids)
+	assert.Equal(t, 2, maxInFlight, "pages should be fetched at the same time, up to the concurrency limit")
+}
+
+func TestFetchRemainingPagesError(t *testing.T) {
+	var mu sync.Mutex
+	fetched := map[int]bool{}
+
+	err := fetchRemainingPages(context.TODO(), 10, 1, func(ctx context.Context, page int) error {
+		mu.Lock()
+		fetched[page] = true
+		mu.Unlock()
+
+		if page >= 3 {
+			return fmt.Errorf("page %d failed", page)
+		}
+
+		return nil
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, "page 3 failed", err.Error(), "the earliest failed page should be reported")
+	assert.False(t, fetched[10], "pages should stop being fetched once one fails")
+}
+
+func TestFetchRemainingPagesCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+
+	err := fetchRemainingPages(ctx, 3, 2, func(ctx context.Context, page int) error {
+		return ctx.Err()
+	})
+
+	assert.True(t, errors.Is(err, context.Canceled), "expected the context's error, got %v", err)
+}
@@ -0,0 +1,104 @@
+package firehydrant
+
+import (
+	"context"
+	"time"
+
+	"github.com/dghubble/sling"
+	"github.com/pkg/errors"
+)
+
+// PriorityResponse is the payload for retrieving a priority
+// URL: GET https://api.firehydrant.io/v1/priorities/{slug}
+type PriorityResponse struct {
+	Slug        string    `json:"slug"`
+	Description string    `json:"description"`
+	Default     bool      `json:"default"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CreatePriorityRequest is the payload for creating a priority
+// URL: POST https://api.firehydrant.io/v1/priorities
+type CreatePriorityRequest struct {
+	Slug        string `json:"slug"`
+	Description string `json:"description"`
+	Default     bool   `json:"default"`
+}
+
+// UpdatePriorityRequest is the payload for updating a priority
+// URL: PATCH https://api.firehydrant.io/v1/priorities/{slug}
+type UpdatePriorityRequest struct {
+	Description string `json:"description"`
+	Default     bool   `json:"default"`
+}
+
+// PrioritiesClient is an interface for interacting with priorities on FireHydrant
+type PrioritiesClient interface {
+	Get(ctx context.Context, slug string) (*PriorityResponse, error)
+	Create(ctx context.Context, createReq CreatePriorityRequest) (*PriorityResponse, error)
+	Update(ctx context.Context, slug string, updateReq UpdatePriorityRequest) (*PriorityResponse, error)
+	Delete(ctx context.Context, slug string) error
+}
+
+// RESTPrioritiesClient implements the PrioritiesClient interface
+type RESTPrioritiesClient struct {
+	client *APIClient
+}
+
+var _ PrioritiesClient = &RESTPrioritiesClient{}
+
+func (c *RESTPrioritiesClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
+}
+
+// Get returns a priority from the FireHydrant API
+func (c *RESTPrioritiesClient) Get(ctx context.Context, slug string) (*PriorityResponse, error) {
+	res := &PriorityResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get("priorities/"+slug).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get priority")
+	}
+
+	return res, nil
+}
+
+// Create creates a priority in FireHydrant
+func (c *RESTPrioritiesClient) Create(ctx context.Context, createReq CreatePriorityRequest) (*PriorityResponse, error) {
+	res := &PriorityResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Post("priorities").BodyJSON(&createReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not create priority")
+	}
+
+	return res, nil
+}
+
+// Update updates a priority in FireHydrant
+func (c *RESTPrioritiesClient) Update(ctx context.Context, slug string, updateReq UpdatePriorityRequest) (*PriorityResponse, error) {
+	res := &PriorityResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Patch("priorities/"+slug).BodyJSON(&updateReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not update priority")
+	}
+
+	return res, nil
+}
+
+// Delete deletes a priority from FireHydrant
+func (c *RESTPrioritiesClient) Delete(ctx context.Context, slug string) error {
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Delete("priorities/"+slug).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not delete priority")
+	}
+
+	return nil
+}
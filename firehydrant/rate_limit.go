@@ -0,0 +1,45 @@
+package firehydrant
+
+import (
+	"net/http"
+	"strconv"
+)
+
+const (
+	rateLimitRemainingHeader = "X-RateLimit-Remaining"
+	rateLimitLimitHeader     = "X-RateLimit-Limit"
+)
+
+// RateLimitObserver is told how many requests are left out of FireHydrant's rate limit, such as
+// for logging or emitting metrics while tuning how many requests are made at once
+type RateLimitObserver func(remaining, limit int)
+
+// rateLimitTransport reports the rate limit headers of every response to its observers. Responses
+// without both headers, or with values that are not numbers, are not reported
+type rateLimitTransport struct {
+	next      http.RoundTripper
+	observers []RateLimitObserver
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	remaining, err := strconv.Atoi(resp.Header.Get(rateLimitRemainingHeader))
+	if err != nil {
+		return resp, nil
+	}
+
+	limit, err := strconv.Atoi(resp.Header.Get(rateLimitLimitHeader))
+	if err != nil {
+		return resp, nil
+	}
+
+	for _, observe := range t.observers {
+		observe(remaining, limit)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,56 @@
+package firehydrant
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitObserver(t *testing.T) {
+	requests := 0
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests++
+
+		switch requests {
+		case 1:
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Limit", "100")
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.Header().Set("X-RateLimit-Remaining", "99")
+			w.Header().Set("X-RateLimit-Limit", "100")
+			w.Write([]byte(`{"response": "pong"}`))
+		default:
+			// Responses that don't report the rate limit aren't observed
+			w.Write([]byte(`{"response": "pong"}`))
+		}
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	type observation struct{ remaining, limit int }
+	var observed []observation
+
+	c, err := NewRestClient("testing-123",
+		WithBaseURL(ts.URL),
+		WithRetries(1, time.Millisecond),
+		WithRateLimitObserver(func(remaining, limit int) {
+			observed = append(observed, observation{remaining, limit})
+		}),
+	)
+	require.NoError(t, err)
+
+	_, err = c.Ping(context.TODO())
+	require.NoError(t, err)
+	_, err = c.Ping(context.TODO())
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, requests)
+	assert.Equal(t, []observation{{0, 100}, {99, 100}}, observed, "every attempt reporting the rate limit should be observed")
+}
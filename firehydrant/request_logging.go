@@ -0,0 +1,107 @@
+package firehydrant
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// RequestLogger is given a description of every request made to FireHydrant and of its response,
+// such as for debug logging. Headers are left out, so the API key is never included, and the values
+// of secret fields in bodies are redacted
+type RequestLogger func(message string)
+
+// redacted replaces the values of secret fields in logged bodies
+const redacted = "REDACTED"
+
+// secretFieldNames are parts of JSON field names whose values are never logged, such as a webhook's
+// signing secret
+var secretFieldNames = []string{"secret", "token", "password", "api_key"}
+
+// loggingTransport describes every attempt at a request to its logger, including each retry
+type loggingTransport struct {
+	next   http.RoundTripper
+	logger RequestLogger
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil && req.GetBody != nil {
+		if reqBody, err := req.GetBody(); err == nil {
+			body, _ = ioutil.ReadAll(reqBody)
+			reqBody.Close()
+		}
+	}
+	t.logger(fmt.Sprintf("FireHydrant request: %s %s%s", req.Method, req.URL, loggedBody(body)))
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		t.logger(fmt.Sprintf("FireHydrant request failed: %s %s: %s", req.Method, req.URL, err))
+		return resp, err
+	}
+
+	// The body is read here for logging, so it's replaced for whoever reads the response next
+	body, err = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	t.logger(fmt.Sprintf("FireHydrant response: %s for %s %s%s", resp.Status, req.Method, req.URL, loggedBody(body)))
+
+	return resp, nil
+}
+
+// loggedBody formats a body for logging with the values of secret fields redacted. Bodies that are
+// not JSON are only described by their size, since their secrets can't be found
+func loggedBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return fmt.Sprintf("\n(%d byte body that is not JSON)", len(body))
+	}
+
+	logged, err := json.Marshal(redactSecrets(v))
+	if err != nil {
+		return fmt.Sprintf("\n(%d byte body)", len(body))
+	}
+
+	return "\n" + string(logged)
+}
+
+func redactSecrets(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for key, field := range value {
+			if isSecretField(key) {
+				value[key] = redacted
+			} else {
+				value[key] = redactSecrets(field)
+			}
+		}
+	case []interface{}:
+		for index, item := range value {
+			value[index] = redactSecrets(item)
+		}
+	}
+
+	return v
+}
+
+func isSecretField(name string) bool {
+	name = strings.ToLower(name)
+	for _, secret := range secretFieldNames {
+		if strings.Contains(name, secret) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,50 @@
+package firehydrant
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestLogger(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": "webhook-id", "url": "https://example.com/hook", "secret": "shh-response"}`))
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	var messages []string
+	c, err := NewRestClient("testing-123", WithBaseURL(ts.URL), WithRequestLogger(func(message string) {
+		messages = append(messages, message)
+	}))
+	require.NoError(t, err)
+
+	res, err := c.Webhooks().Create(context.TODO(), CreateWebhookRequest{URL: "https://example.com/hook", Secret: "shh-request"})
+	require.NoError(t, err)
+	assert.Equal(t, "webhook-id", res.ID, "the response should still be readable after it is logged")
+
+	require.Len(t, messages, 2)
+	assert.Contains(t, messages[0], "FireHydrant request: POST "+ts.URL+"/webhooks")
+	assert.Contains(t, messages[0], `"url":"https://example.com/hook"`)
+	assert.Contains(t, messages[1], "FireHydrant response: 201 Created for POST "+ts.URL+"/webhooks")
+
+	logged := strings.Join(messages, "\n")
+	for _, secret := range []string{"shh-request", "shh-response", "testing-123"} {
+		assert.NotContains(t, logged, secret)
+	}
+	assert.Contains(t, logged, `"secret":"REDACTED"`)
+}
+
+func TestLoggedBodyRedactsNestedSecrets(t *testing.T) {
+	body := loggedBody([]byte(`{"name": "PagerDuty", "config": {"api_key": "abc", "routing": [{"integration_token": "def"}]}}`))
+	assert.Equal(t, "\n"+`{"config":{"api_key":"REDACTED","routing":[{"integration_token":"REDACTED"}]},"name":"PagerDuty"}`, body)
+
+	assert.Equal(t, "\n(9 byte body that is not JSON)", loggedBody([]byte("not json!")))
+	assert.Equal(t, "", loggedBody(nil))
+}
@@ -0,0 +1,63 @@
+package firehydrant
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+type operationTimeoutKey struct{}
+
+// WithOperationTimeout replaces the client's request timeout for every request made with the returned
+// context, such as a create that FireHydrant is known to take a while on. A timeout of zero leaves
+// the requests bounded only by the context's own deadline
+func WithOperationTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, operationTimeoutKey{}, timeout)
+}
+
+// contextDoer sends each request with the context of the operation that made it, so that requests
+// stop once the operation is canceled or runs out of time
+type contextDoer struct {
+	client  *http.Client
+	ctx     context.Context
+	timeout time.Duration
+}
+
+func (d *contextDoer) Do(req *http.Request) (*http.Response, error) {
+	ctx := d.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	timeout := d.timeout
+	if t, ok := ctx.Value(operationTimeoutKey{}).(time.Duration); ok {
+		timeout = t
+	}
+
+	if timeout <= 0 {
+		return d.client.Do(req.WithContext(ctx))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	resp, err := d.client.Do(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// The deadline has to last until the body is read, so it's only released once the body is closed
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
@@ -0,0 +1,71 @@
+package firehydrant
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func slowServer(delay time.Duration) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		select {
+		case <-time.After(delay):
+		case <-req.Context().Done():
+			return
+		}
+
+		w.Write([]byte(serviceResponseJSON))
+	}))
+}
+
+func TestRequestTimeout(t *testing.T) {
+	ts := slowServer(time.Second)
+	defer ts.Close()
+
+	c, err := NewRestClient("testing-123", WithBaseURL(ts.URL), WithRequestTimeout(50*time.Millisecond))
+	require.NoError(t, err)
+
+	_, err = c.Services().Get(context.TODO(), "service-id")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "expected the request to time out, got %s", err)
+}
+
+func TestOperationTimeoutReplacesRequestTimeout(t *testing.T) {
+	ts := slowServer(100 * time.Millisecond)
+	defer ts.Close()
+
+	c, err := NewRestClient("testing-123", WithBaseURL(ts.URL), WithRequestTimeout(10*time.Millisecond))
+	require.NoError(t, err)
+
+	ctx := WithOperationTimeout(context.TODO(), 5*time.Second)
+	res, err := c.Services().Create(ctx, CreateServiceRequest{Name: "Chow Hall"})
+	require.NoError(t, err)
+	assert.Equal(t, "Chow Hall", res.Name)
+}
+
+func TestRequestsStopWithTheirContext(t *testing.T) {
+	ts := slowServer(time.Second)
+	defer ts.Close()
+
+	c, err := NewRestClient("testing-123", WithBaseURL(ts.URL))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = c.Services().Get(ctx, "service-id")
+	require.Error(t, err)
+	assert.Less(t, int64(time.Since(start)), int64(time.Second), "request should stop when its context's deadline passes")
+}
+
+func TestRequestTimeoutMustNotBeNegative(t *testing.T) {
+	_, err := NewRestClient("testing-123", WithRequestTimeout(-time.Second))
+	assert.Error(t, err)
+}
@@ -0,0 +1,131 @@
+package firehydrant
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// responseCacheTransport answers GET requests from responses it has already received for the same URL
+// until they are older than ttl. Any other request clears the cached responses for the same kind of
+// resource once it finishes, so that writing a team is not followed by reading the old team back. The
+// kind of resource is the first part of the path after the base URL, such as teams for teams/{id}/memberships
+type responseCacheTransport struct {
+	next     http.RoundTripper
+	ttl      time.Duration
+	basePath string
+	now      func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]cachedResponse
+}
+
+type cachedResponse struct {
+	resource   string
+	expiresAt  time.Time
+	statusCode int
+	header     http.Header
+	body       []byte
+}
+
+func newResponseCacheTransport(next http.RoundTripper, ttl time.Duration, baseURL string) *responseCacheTransport {
+	basePath := "/"
+	if u, err := url.Parse(baseURL); err == nil {
+		basePath = u.Path
+	}
+
+	return &responseCacheTransport{
+		next:     next,
+		ttl:      ttl,
+		basePath: basePath,
+		now:      time.Now,
+		entries:  map[string]cachedResponse{},
+	}
+}
+
+func (t *responseCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		resp, err := t.next.RoundTrip(req)
+		t.invalidate(t.resource(req.URL))
+		return resp, err
+	}
+
+	key := req.URL.String()
+	if resp, ok := t.lookup(key, req); ok {
+		return resp, nil
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	t.mu.Lock()
+	t.entries[key] = cachedResponse{
+		resource:   t.resource(req.URL),
+		expiresAt:  t.now().Add(t.ttl),
+		statusCode: resp.StatusCode,
+		header:     resp.Header.Clone(),
+		body:       body,
+	}
+	t.mu.Unlock()
+
+	return resp, nil
+}
+
+func (t *responseCacheTransport) lookup(key string, req *http.Request) (*http.Response, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	entry, ok := t.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	if !t.now().Before(entry.expiresAt) {
+		delete(t.entries, key)
+		return nil, false
+	}
+
+	return &http.Response{
+		Status:        http.StatusText(entry.statusCode),
+		StatusCode:    entry.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        entry.header.Clone(),
+		Body:          ioutil.NopCloser(bytes.NewReader(entry.body)),
+		ContentLength: int64(len(entry.body)),
+		Request:       req,
+	}, true
+}
+
+func (t *responseCacheTransport) invalidate(resource string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for key, entry := range t.entries {
+		if entry.resource == resource {
+			delete(t.entries, key)
+		}
+	}
+}
+
+// resource returns the kind of resource a URL is for, such as services for services/{id}
+func (t *responseCacheTransport) resource(u *url.URL) string {
+	path := strings.TrimPrefix(u.Path, t.basePath)
+	path = strings.TrimPrefix(path, "/")
+
+	return strings.SplitN(path, "/", 2)[0]
+}
@@ -0,0 +1,72 @@
+package firehydrant
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseCache(t *testing.T) {
+	requests := map[string]int{}
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests[req.Method+" "+req.URL.Path]++
+		w.Write([]byte(`{"id": "test-team-id", "name": "Team"}`))
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	c, err := NewRestClient("testing-123", WithBaseURL(ts.URL+"/v1/"), WithResponseCache(time.Minute))
+	require.NoError(t, err)
+
+	now := time.Now()
+	c.httpClient.Transport.(*responseCacheTransport).now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		_, err = c.GetTeam(context.TODO(), "test-team-id")
+		require.NoError(t, err)
+	}
+	_, err = c.Services().Get(context.TODO(), "test-service-id")
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests["GET /v1/teams/test-team-id"], "repeated lookups should be cached")
+	assert.Equal(t, 1, requests["GET /v1/services/test-service-id"])
+
+	// Writing to a team clears the cached teams, but not the cached services
+	_, err = c.UpdateTeam(context.TODO(), "test-team-id", UpdateTeamRequest{Name: "Team"})
+	require.NoError(t, err)
+	_, err = c.GetTeam(context.TODO(), "test-team-id")
+	require.NoError(t, err)
+	_, err = c.Services().Get(context.TODO(), "test-service-id")
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests["GET /v1/teams/test-team-id"], "writes should clear cached lookups")
+	assert.Equal(t, 1, requests["GET /v1/services/test-service-id"])
+
+	// Cached responses expire after the TTL
+	now = now.Add(time.Minute)
+	_, err = c.Services().Get(context.TODO(), "test-service-id")
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests["GET /v1/services/test-service-id"], "expired lookups should be requested again")
+}
+
+func TestResponseCacheIsOptIn(t *testing.T) {
+	requests := 0
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		w.Write([]byte(`{"id": "test-team-id"}`))
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	c, err := NewRestClient("testing-123", WithBaseURL(ts.URL))
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, err = c.GetTeam(context.TODO(), "test-team-id")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, requests)
+}
@@ -0,0 +1,112 @@
+package firehydrant
+
+import (
+	"context"
+	"time"
+
+	"github.com/dghubble/sling"
+	"github.com/pkg/errors"
+)
+
+// RetrospectiveTemplateSection is a single section of a retrospective, with the prompt its authors fill in
+type RetrospectiveTemplateSection struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+// RetrospectiveTemplateResponse is the payload for retrieving a retrospective template
+// URL: GET https://api.firehydrant.io/v1/retrospective_templates/{id}
+type RetrospectiveTemplateResponse struct {
+	ID          string                         `json:"id"`
+	Name        string                         `json:"name"`
+	Description string                         `json:"description"`
+	Sections    []RetrospectiveTemplateSection `json:"sections"`
+	CreatedAt   time.Time                      `json:"created_at"`
+	UpdatedAt   time.Time                      `json:"updated_at"`
+}
+
+// CreateRetrospectiveTemplateRequest is the payload for creating a retrospective template
+// URL: POST https://api.firehydrant.io/v1/retrospective_templates
+type CreateRetrospectiveTemplateRequest struct {
+	Name        string                         `json:"name"`
+	Description string                         `json:"description"`
+	Sections    []RetrospectiveTemplateSection `json:"sections"`
+}
+
+// UpdateRetrospectiveTemplateRequest is the payload for updating a retrospective template
+// URL: PATCH https://api.firehydrant.io/v1/retrospective_templates/{id}
+type UpdateRetrospectiveTemplateRequest struct {
+	Name        string                         `json:"name,omitempty"`
+	Description string                         `json:"description"`
+	Sections    []RetrospectiveTemplateSection `json:"sections"`
+}
+
+// RetrospectiveTemplatesClient is an interface for interacting with retrospective templates on FireHydrant
+type RetrospectiveTemplatesClient interface {
+	Get(ctx context.Context, id string) (*RetrospectiveTemplateResponse, error)
+	Create(ctx context.Context, createReq CreateRetrospectiveTemplateRequest) (*RetrospectiveTemplateResponse, error)
+	Update(ctx context.Context, id string, updateReq UpdateRetrospectiveTemplateRequest) (*RetrospectiveTemplateResponse, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// RESTRetrospectiveTemplatesClient implements the RetrospectiveTemplatesClient interface
+type RESTRetrospectiveTemplatesClient struct {
+	client *APIClient
+}
+
+var _ RetrospectiveTemplatesClient = &RESTRetrospectiveTemplatesClient{}
+
+func (c *RESTRetrospectiveTemplatesClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
+}
+
+// Get returns a retrospective template from the FireHydrant API
+func (c *RESTRetrospectiveTemplatesClient) Get(ctx context.Context, id string) (*RetrospectiveTemplateResponse, error) {
+	res := &RetrospectiveTemplateResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get("retrospective_templates/"+id).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get retrospective template")
+	}
+
+	return res, nil
+}
+
+// Create creates a retrospective template in FireHydrant
+func (c *RESTRetrospectiveTemplatesClient) Create(ctx context.Context, createReq CreateRetrospectiveTemplateRequest) (*RetrospectiveTemplateResponse, error) {
+	res := &RetrospectiveTemplateResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Post("retrospective_templates").BodyJSON(&createReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not create retrospective template")
+	}
+
+	return res, nil
+}
+
+// Update updates a retrospective template in FireHydrant
+func (c *RESTRetrospectiveTemplatesClient) Update(ctx context.Context, id string, updateReq UpdateRetrospectiveTemplateRequest) (*RetrospectiveTemplateResponse, error) {
+	res := &RetrospectiveTemplateResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Patch("retrospective_templates/"+id).BodyJSON(&updateReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not update retrospective template")
+	}
+
+	return res, nil
+}
+
+// Delete deletes a retrospective template from FireHydrant
+func (c *RESTRetrospectiveTemplatesClient) Delete(ctx context.Context, id string) error {
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Delete("retrospective_templates/"+id).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not delete retrospective template")
+	}
+
+	return nil
+}
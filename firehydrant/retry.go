@@ -0,0 +1,140 @@
+package firehydrant
+
+import (
+	cryptorand "crypto/rand"
+	"encoding/hex"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a rate limited or failed request is retried
+	DefaultMaxRetries = 3
+	// DefaultRetryBaseDelay is the delay before the first retry, doubling on every retry after it
+	DefaultRetryBaseDelay = 500 * time.Millisecond
+	// maxRetryDelay caps how long a single backoff can wait
+	maxRetryDelay = 30 * time.Second
+)
+
+// retryTransport retries requests that FireHydrant rate limited (429) or failed with a 5xx.
+// Rate limited requests were never processed, so they're retried regardless of method, but
+// server errors are only retried for idempotent methods so a create is never duplicated.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	baseDelay  time.Duration
+}
+
+// idempotencyKeyHeader lets FireHydrant recognize a create it has already processed, so that a
+// retried create does not make a duplicate
+const idempotencyKeyHeader = "Idempotency-Key"
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Every attempt at the same create shares one key
+	if req.Method == http.MethodPost && req.Header.Get(idempotencyKeyHeader) == "" {
+		key, err := newIdempotencyKey()
+		if err != nil {
+			return nil, err
+		}
+
+		req = req.Clone(req.Context())
+		req.Header.Set(idempotencyKeyHeader, key)
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+		if err != nil || attempt >= t.maxRetries || !shouldRetry(req, resp) {
+			return resp, err
+		}
+
+		// The body has to be rewound before the request can be sent again
+		if req.Body != nil {
+			if req.GetBody == nil {
+				return resp, nil
+			}
+
+			body, err := req.GetBody()
+			if err != nil {
+				return resp, nil
+			}
+
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		delay := retryDelay(resp, attempt, t.baseDelay)
+		resp.Body.Close()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func shouldRetry(req *http.Request, resp *http.Response) bool {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	if resp.StatusCode >= 500 {
+		switch req.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+			return true
+		}
+	}
+
+	return false
+}
+
+// retryDelay honors the Retry-After header when FireHydrant sends one, falling back to
+// exponential backoff with jitter
+func retryDelay(resp *http.Response, attempt int, baseDelay time.Duration) time.Duration {
+	if after := resp.Header.Get("Retry-After"); after != "" {
+		if seconds, err := strconv.Atoi(after); err == nil && seconds >= 0 {
+			return capDelay(time.Duration(seconds) * time.Second)
+		}
+
+		if at, err := http.ParseTime(after); err == nil {
+			return capDelay(time.Until(at))
+		}
+	}
+
+	if baseDelay <= 0 {
+		return 0
+	}
+
+	backoff := baseDelay << uint(attempt)
+	if backoff <= 0 || backoff > maxRetryDelay {
+		backoff = maxRetryDelay
+	}
+
+	// Jitter keeps many concurrent applies from retrying in lockstep
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
+
+func capDelay(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+
+	if d > maxRetryDelay {
+		return maxRetryDelay
+	}
+
+	return d
+}
+
+func newIdempotencyKey() (string, error) {
+	b := make([]byte, 16)
+	if _, err := cryptorand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
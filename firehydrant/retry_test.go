@@ -0,0 +1,119 @@
+package firehydrant
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetriesRateLimitedRequests(t *testing.T) {
+	attempts := 0
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		w.Write([]byte(serviceResponseJSON))
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	c, err := NewRestClient("testing-123", WithBaseURL(ts.URL), WithRetries(3, time.Millisecond))
+	require.NoError(t, err)
+
+	res, err := c.Services().Create(context.TODO(), CreateServiceRequest{Name: "Chow Hall"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, attempts, "rate limited create should be retried until it succeeds")
+	assert.Equal(t, "Chow Hall", res.Name)
+}
+
+func TestRetriesStopAtMaxRetries(t *testing.T) {
+	attempts := 0
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	c, err := NewRestClient("testing-123", WithBaseURL(ts.URL), WithRetries(2, time.Millisecond))
+	require.NoError(t, err)
+
+	_, _ = c.Services().Get(context.TODO(), "service-id")
+	assert.Equal(t, 3, attempts, "expected the initial request plus two retries")
+}
+
+func TestRetriesDoNotRepeatFailedCreates(t *testing.T) {
+	attempts := 0
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	c, err := NewRestClient("testing-123", WithBaseURL(ts.URL), WithRetries(3, time.Millisecond))
+	require.NoError(t, err)
+
+	_, _ = c.Services().Create(context.TODO(), CreateServiceRequest{Name: "fake-service"})
+	assert.Equal(t, 1, attempts, "a create that failed on the server must not be retried")
+}
+
+func TestRetriesReuseIdempotencyKey(t *testing.T) {
+	var keys []string
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		keys = append(keys, req.Header.Get("Idempotency-Key"))
+		if len(keys) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		w.Write([]byte(serviceResponseJSON))
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	c, err := NewRestClient("testing-123", WithBaseURL(ts.URL), WithRetries(3, time.Millisecond))
+	require.NoError(t, err)
+
+	_, err = c.Services().Create(context.TODO(), CreateServiceRequest{Name: "Chow Hall"})
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.NotEmpty(t, keys[0], "creates should send an idempotency key")
+	assert.Equal(t, keys[0], keys[1], "a retried create should reuse its idempotency key")
+
+	_, err = c.Services().Create(context.TODO(), CreateServiceRequest{Name: "Chow Hall"})
+	require.NoError(t, err)
+	assert.NotEqual(t, keys[0], keys[2], "each create should get its own idempotency key")
+
+	_, err = c.Services().Get(context.TODO(), "service-id")
+	require.NoError(t, err)
+	assert.Empty(t, keys[3], "only creates should send an idempotency key")
+}
+
+func TestRetryDelay(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+
+	resp.Header.Set("Retry-After", "7")
+	assert.Equal(t, 7*time.Second, retryDelay(resp, 0, time.Second))
+
+	resp.Header.Set("Retry-After", "3600")
+	assert.Equal(t, maxRetryDelay, retryDelay(resp, 0, time.Second))
+
+	resp.Header.Del("Retry-After")
+	for attempt := 0; attempt < 4; attempt++ {
+		backoff := time.Second << uint(attempt)
+		delay := retryDelay(resp, attempt, time.Second)
+		assert.GreaterOrEqual(t, int64(delay), int64(backoff/2))
+		assert.LessOrEqual(t, int64(delay), int64(backoff))
+	}
+}
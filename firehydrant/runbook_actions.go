@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/dghubble/sling"
@@ -17,11 +18,26 @@ type RunbookActionsResponse struct {
 // RunbookResponse is the payload for retrieving a service
 // URL: GET https://api.firehydrant.io/v1/runbooks/{id}
 type RunbookAction struct {
-	ID        string    `json:"id"`
-	Name      string    `json:"name"`
-	Slug      string    `json:"slug"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID           string                     `json:"id"`
+	Name         string                     `json:"name"`
+	Slug         string                     `json:"slug"`
+	Integration  *RunbookActionIntegration  `json:"integration"`
+	ConfigFields []RunbookActionConfigField `json:"config_fields"`
+	CreatedAt    time.Time                  `json:"created_at"`
+	UpdatedAt    time.Time                  `json:"updated_at"`
+}
+
+// RunbookActionIntegration is the integration that performs a runbook action, such as Slack
+type RunbookActionIntegration struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// RunbookActionConfigField is a setting a runbook step using the action can be configured with
+type RunbookActionConfigField struct {
+	Name     string `json:"name"`
+	Required bool   `json:"required"`
 }
 
 type RunbookActionsQuery struct {
@@ -31,6 +47,15 @@ type RunbookActionsQuery struct {
 // RunbooksClient is an interface for interacting with runbooks on FireHydrant
 type RunbookActionsClient interface {
 	Get(ctx context.Context, typ, integrationAndSlug string) (*RunbookAction, error)
+	List(ctx context.Context, typ string) (*RunbookActionsResponse, error)
+}
+
+// runbookActionsCache keeps the runbook actions of each type for the life of a client, which for
+// the provider is a single Terraform run. The available actions only change when integrations are
+// connected, so there's no need to fetch them for every step and data source
+type runbookActionsCache struct {
+	mu      sync.Mutex
+	actions map[string]*RunbookActionsResponse
 }
 
 // RESTRunbooksClient implements the RunbooksClient interface
@@ -40,17 +65,15 @@ type RESTRunbookActionsClient struct {
 
 var _ RunbookActionsClient = &RESTRunbookActionsClient{}
 
-func (c *RESTRunbookActionsClient) restClient() *sling.Sling {
-	return c.client.client()
+func (c *RESTRunbookActionsClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
 }
 
 // Get returns a runbook action from the FireHydrant API
 func (c *RESTRunbookActionsClient) Get(ctx context.Context, typ, integrationAndSlug string) (*RunbookAction, error) {
-	res := &RunbookActionsResponse{}
-	query := RunbookActionsQuery{Type: typ}
-	_, err := c.restClient().Get("runbooks/actions").QueryStruct(query).Receive(res, nil)
+	res, err := c.List(ctx, typ)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not get runbook")
+		return nil, err
 	}
 
 	split := strings.Split(integrationAndSlug, ".")
@@ -62,5 +85,29 @@ func (c *RESTRunbookActionsClient) Get(ctx context.Context, typ, integrationAndS
 		}
 	}
 
-	return nil, fmt.Errorf("could not find runbook action")
+	return nil, NotFound(fmt.Sprintf("Could not find runbook action %s", integrationAndSlug))
+}
+
+// List returns every runbook action of a type, such as incident, from the FireHydrant API. The
+// actions are only fetched once per client
+func (c *RESTRunbookActionsClient) List(ctx context.Context, typ string) (*RunbookActionsResponse, error) {
+	cache := c.client.runbookActions
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if res, ok := cache.actions[typ]; ok {
+		return res, nil
+	}
+
+	res := &RunbookActionsResponse{}
+	apiErr := &APIError{}
+	query := RunbookActionsQuery{Type: typ}
+
+	resp, err := c.restClient(ctx).Get("runbooks/actions").QueryStruct(query).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get runbook actions")
+	}
+
+	cache.actions[typ] = res
+	return res, nil
 }
@@ -0,0 +1,43 @@
+package firehydrant
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const runbookActionsResponseJSON = `{"data": [
+	{"id": "slack-channel", "name": "Create Incident Channel", "slug": "create_incident_channel", "integration": {"slug": "slack"}, "config_fields": [{"name": "channel_name_format", "required": true}, {"name": "channel_topic", "required": false}]},
+	{"id": "jira-ticket", "name": "Create Jira Ticket", "slug": "create_jira_ticket", "integration": {"slug": "jira_cloud"}}
+]}`
+
+func TestListRunbookActionsIsCached(t *testing.T) {
+	requests := 0
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		assert.Equal(t, "incident", req.URL.Query().Get("type"))
+
+		w.Write([]byte(runbookActionsResponseJSON))
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	c, err := NewRestClient("testing-123", WithBaseURL(ts.URL))
+	require.NoError(t, err)
+
+	res, err := c.RunbookActions().List(context.TODO(), "incident")
+	require.NoError(t, err, "error listing runbook actions")
+	require.Len(t, res.Actions, 2)
+	assert.Equal(t, "slack", res.Actions[0].Integration.Slug)
+	assert.Equal(t, []RunbookActionConfigField{{Name: "channel_name_format", Required: true}, {Name: "channel_topic"}}, res.Actions[0].ConfigFields)
+
+	action, err := c.RunbookActions().Get(context.TODO(), "incident", "jira_cloud.create_jira_ticket")
+	require.NoError(t, err, "error getting a runbook action")
+	assert.Equal(t, "jira-ticket", action.ID)
+
+	assert.Equal(t, 1, requests, "runbook actions should only be fetched once per client")
+}
@@ -2,7 +2,6 @@ package firehydrant
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/dghubble/sling"
@@ -62,12 +61,26 @@ type RunbookResponse struct {
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+// RunbookAttachmentRule decides which incidents a runbook is attached to. An incident matches when it
+// has one of the listed items for every kind that is set, so a rule with services and severities only
+// attaches to incidents on one of those services with one of those severities
+// URL: GET https://api.firehydrant.io/v1/runbooks/{id}/attachment_rule
+// URL: PUT https://api.firehydrant.io/v1/runbooks/{id}/attachment_rule
+type RunbookAttachmentRule struct {
+	Services     []RunbookRelation `json:"services"`
+	Environments []RunbookRelation `json:"environments"`
+	Severities   []RunbookRelation `json:"severities"`
+}
+
 // RunbooksClient is an interface for interacting with runbooks on FireHydrant
 type RunbooksClient interface {
 	Get(ctx context.Context, id string) (*RunbookResponse, error)
 	Create(ctx context.Context, createReq CreateRunbookRequest) (*RunbookResponse, error)
 	Update(ctx context.Context, id string, updateReq UpdateRunbookRequest) (*RunbookResponse, error)
 	Delete(ctx context.Context, id string) error
+	GetAttachmentRule(ctx context.Context, id string) (*RunbookAttachmentRule, error)
+	UpdateAttachmentRule(ctx context.Context, id string, req RunbookAttachmentRule) (*RunbookAttachmentRule, error)
+	DeleteAttachmentRule(ctx context.Context, id string) error
 }
 
 // RESTRunbooksClient implements the RunbooksClient interface
@@ -77,39 +90,33 @@ type RESTRunbooksClient struct {
 
 var _ RunbooksClient = &RESTRunbooksClient{}
 
-func (c *RESTRunbooksClient) restClient() *sling.Sling {
-	return c.client.client()
+func (c *RESTRunbooksClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
 }
 
 // Get returns a runbook from the FireHydrant API
 func (c *RESTRunbooksClient) Get(ctx context.Context, id string) (*RunbookResponse, error) {
 	res := &RunbookResponse{}
-	resp, err := c.restClient().Get("runbooks/"+id).Receive(res, nil)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not get runbook")
-	}
+	apiErr := &APIError{}
 
-	if resp.StatusCode == 404 {
-		return nil, NotFound(fmt.Sprintf("Could not find runbook with ID %s", id))
+	resp, err := c.restClient(ctx).Get("runbooks/"+id).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get runbook")
 	}
 
 	return res, nil
 }
 
 // Create creates a brand spankin new runbook in FireHydrant
-// TODO: Check failure case
 func (c *RESTRunbooksClient) Create(ctx context.Context, createReq CreateRunbookRequest) (*RunbookResponse, error) {
 	res := &RunbookResponse{}
-	resp, err := c.restClient().Post("runbooks").BodyJSON(&createReq).Receive(res, nil)
+	apiErr := &APIError{}
 
-	if err != nil {
+	resp, err := c.restClient(ctx).Post("runbooks").BodyJSON(&createReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
 		return nil, errors.Wrap(err, "could not create runbook")
 	}
 
-	if resp.StatusCode != 201 {
-		return nil, fmt.Errorf("error creating runbook: status %d", resp.StatusCode)
-	}
-
 	res, err = c.Update(ctx, res.ID, UpdateRunbookRequest{
 		Steps:      createReq.Steps,
 		Severities: createReq.Severities,
@@ -124,25 +131,63 @@ func (c *RESTRunbooksClient) Create(ctx context.Context, createReq CreateRunbook
 // Update updates a runbook in FireHydrant
 func (c *RESTRunbooksClient) Update(ctx context.Context, id string, updateReq UpdateRunbookRequest) (*RunbookResponse, error) {
 	res := &RunbookResponse{}
-	resp, err := c.restClient().Put("runbooks/"+id).BodyJSON(updateReq).Receive(res, nil)
+	apiErr := &APIError{}
 
-	if err != nil {
+	resp, err := c.restClient(ctx).Put("runbooks/"+id).BodyJSON(updateReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
 		return nil, errors.Wrap(err, "could not update runbook")
 	}
 
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("error creating runbook: status %d", resp.StatusCode)
-	}
-
 	return res, nil
 }
 
+// Delete deletes a runbook from FireHydrant
 func (c *RESTRunbooksClient) Delete(ctx context.Context, id string) error {
-	_, err := c.restClient().Delete("runbooks/"+id).Receive(nil, nil)
+	apiErr := &APIError{}
 
-	if err != nil {
+	resp, err := c.restClient(ctx).Delete("runbooks/"+id).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
 		return errors.Wrap(err, "could not delete runbook")
 	}
 
 	return nil
 }
+
+// GetAttachmentRule returns which incidents a runbook is attached to
+func (c *RESTRunbooksClient) GetAttachmentRule(ctx context.Context, id string) (*RunbookAttachmentRule, error) {
+	res := &RunbookAttachmentRule{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get("runbooks/"+id+"/attachment_rule").Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get runbook attachment rule")
+	}
+
+	return res, nil
+}
+
+// UpdateAttachmentRule replaces which incidents a runbook is attached to
+func (c *RESTRunbooksClient) UpdateAttachmentRule(ctx context.Context, id string, updateReq RunbookAttachmentRule) (*RunbookAttachmentRule, error) {
+	res := &RunbookAttachmentRule{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Put("runbooks/"+id+"/attachment_rule").BodyJSON(&updateReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not update runbook attachment rule")
+	}
+
+	return res, nil
+}
+
+// DeleteAttachmentRule detaches a runbook, so it is only attached to incidents by hand
+// URL: DELETE https://api.firehydrant.io/v1/runbooks/{id}/attachment_rule
+func (c *RESTRunbooksClient) DeleteAttachmentRule(ctx context.Context, id string) error {
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Delete("runbooks/"+id+"/attachment_rule").Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not delete runbook attachment rule")
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package firehydrant
+
+import (
+	"context"
+	"time"
+
+	"github.com/dghubble/sling"
+	"github.com/pkg/errors"
+)
+
+// SavedSearchResponse is the payload for retrieving a saved search
+// URL: GET https://api.firehydrant.io/v1/saved_searches/{resource_type}/{id}
+type SavedSearchResponse struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	ResourceType string `json:"resource_type"`
+	IsPrivate    bool   `json:"is_private"`
+
+	// FilterValues are the filters the search applies, in the same shape as FireHydrant's filter query
+	FilterValues map[string]interface{} `json:"filter_values"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CreateSavedSearchRequest is the payload for creating a saved search
+// URL: POST https://api.firehydrant.io/v1/saved_searches/{resource_type}
+type CreateSavedSearchRequest struct {
+	Name         string                 `json:"name"`
+	IsPrivate    bool                   `json:"is_private"`
+	FilterValues map[string]interface{} `json:"filter_values"`
+}
+
+// SavedSearchesClient is an interface for interacting with saved searches on FireHydrant. Searches are
+// saved for a kind of resource, such as incidents
+type SavedSearchesClient interface {
+	Get(ctx context.Context, resourceType, id string) (*SavedSearchResponse, error)
+	Create(ctx context.Context, resourceType string, createReq CreateSavedSearchRequest) (*SavedSearchResponse, error)
+	Delete(ctx context.Context, resourceType, id string) error
+}
+
+// RESTSavedSearchesClient implements the SavedSearchesClient interface
+type RESTSavedSearchesClient struct {
+	client *APIClient
+}
+
+var _ SavedSearchesClient = &RESTSavedSearchesClient{}
+
+func (c *RESTSavedSearchesClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
+}
+
+func savedSearchPath(resourceType string) string {
+	return "saved_searches/" + resourceType
+}
+
+// Get returns a saved search from the FireHydrant API
+func (c *RESTSavedSearchesClient) Get(ctx context.Context, resourceType, id string) (*SavedSearchResponse, error) {
+	res := &SavedSearchResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get(savedSearchPath(resourceType)+"/"+id).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get saved search")
+	}
+
+	return res, nil
+}
+
+// Create saves a search for a kind of resource in FireHydrant
+func (c *RESTSavedSearchesClient) Create(ctx context.Context, resourceType string, createReq CreateSavedSearchRequest) (*SavedSearchResponse, error) {
+	res := &SavedSearchResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Post(savedSearchPath(resourceType)).BodyJSON(&createReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not create saved search")
+	}
+
+	return res, nil
+}
+
+// Delete deletes a saved search from FireHydrant
+func (c *RESTSavedSearchesClient) Delete(ctx context.Context, resourceType, id string) error {
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Delete(savedSearchPath(resourceType)+"/"+id).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not delete saved search")
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package firehydrant
+
+import (
+	"context"
+	"time"
+
+	"github.com/dghubble/sling"
+	"github.com/pkg/errors"
+)
+
+// ScheduledMaintenanceService is a service a scheduled maintenance affects
+type ScheduledMaintenanceService struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// ScheduledMaintenanceResponse is the payload for retrieving a scheduled maintenance
+// URL: GET https://api.firehydrant.io/v1/maintenances/{id}
+type ScheduledMaintenanceResponse struct {
+	ID          string                        `json:"id"`
+	Title       string                        `json:"title"`
+	Description string                        `json:"description"`
+	StartsAt    time.Time                     `json:"starts_at"`
+	EndsAt      time.Time                     `json:"ends_at"`
+	Services    []ScheduledMaintenanceService `json:"services"`
+}
+
+// CreateScheduledMaintenanceRequest is the payload for creating a scheduled maintenance
+// URL: POST https://api.firehydrant.io/v1/maintenances
+type CreateScheduledMaintenanceRequest struct {
+	Title       string    `json:"title"`
+	Description string    `json:"description,omitempty"`
+	StartsAt    time.Time `json:"starts_at"`
+	EndsAt      time.Time `json:"ends_at"`
+	Services    []string  `json:"services,omitempty"`
+}
+
+// UpdateScheduledMaintenanceRequest is the payload for updating a scheduled maintenance. The services
+// are always sent so that they can be cleared
+// URL: PATCH https://api.firehydrant.io/v1/maintenances/{id}
+type UpdateScheduledMaintenanceRequest struct {
+	Title       string    `json:"title,omitempty"`
+	Description string    `json:"description"`
+	StartsAt    time.Time `json:"starts_at"`
+	EndsAt      time.Time `json:"ends_at"`
+	Services    []string  `json:"services"`
+}
+
+// ScheduledMaintenancesClient is an interface for interacting with scheduled maintenances on FireHydrant
+type ScheduledMaintenancesClient interface {
+	Get(ctx context.Context, id string) (*ScheduledMaintenanceResponse, error)
+	Create(ctx context.Context, createReq CreateScheduledMaintenanceRequest) (*ScheduledMaintenanceResponse, error)
+	Update(ctx context.Context, id string, updateReq UpdateScheduledMaintenanceRequest) (*ScheduledMaintenanceResponse, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// RESTScheduledMaintenancesClient implements the ScheduledMaintenancesClient interface
+type RESTScheduledMaintenancesClient struct {
+	client *APIClient
+}
+
+var _ ScheduledMaintenancesClient = &RESTScheduledMaintenancesClient{}
+
+func (c *RESTScheduledMaintenancesClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
+}
+
+// Get returns a scheduled maintenance from the FireHydrant API
+func (c *RESTScheduledMaintenancesClient) Get(ctx context.Context, id string) (*ScheduledMaintenanceResponse, error) {
+	res := &ScheduledMaintenanceResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get("maintenances/"+id).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get scheduled maintenance")
+	}
+
+	return res, nil
+}
+
+// Create creates a scheduled maintenance in FireHydrant
+func (c *RESTScheduledMaintenancesClient) Create(ctx context.Context, createReq CreateScheduledMaintenanceRequest) (*ScheduledMaintenanceResponse, error) {
+	res := &ScheduledMaintenanceResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Post("maintenances").BodyJSON(&createReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not create scheduled maintenance")
+	}
+
+	return res, nil
+}
+
+// Update updates a scheduled maintenance in FireHydrant
+func (c *RESTScheduledMaintenancesClient) Update(ctx context.Context, id string, updateReq UpdateScheduledMaintenanceRequest) (*ScheduledMaintenanceResponse, error) {
+	res := &ScheduledMaintenanceResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Patch("maintenances/"+id).BodyJSON(&updateReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not update scheduled maintenance")
+	}
+
+	return res, nil
+}
+
+// Delete deletes a scheduled maintenance from FireHydrant
+func (c *RESTScheduledMaintenancesClient) Delete(ctx context.Context, id string) error {
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Delete("maintenances/"+id).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not delete scheduled maintenance")
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package firehydrant
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateScheduledMaintenance(t *testing.T) {
+	resp := &ScheduledMaintenanceResponse{}
+	req := CreateScheduledMaintenanceRequest{
+		Title:    "Database upgrade",
+		StartsAt: time.Date(2021, 6, 1, 2, 0, 0, 0, time.UTC),
+		EndsAt:   time.Date(2021, 6, 1, 4, 0, 0, 0, time.UTC),
+		Services: []string{"test-service-id"},
+	}
+	c, teardown, err := setupClient("/maintenances", resp,
+		AssertRequestJSONBody(t, req),
+		AssertRequestMethod(t, "POST"),
+	)
+	require.NoError(t, err)
+	defer teardown()
+
+	_, err = c.ScheduledMaintenances().Create(context.TODO(), req)
+	require.NoError(t, err, "error creating a scheduled maintenance")
+}
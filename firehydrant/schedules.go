@@ -0,0 +1,160 @@
+package firehydrant
+
+import (
+	"context"
+	"time"
+
+	"github.com/dghubble/sling"
+	"github.com/pkg/errors"
+)
+
+// ScheduleStrategy describes how an on-call schedule hands off between members
+type ScheduleStrategy struct {
+	Type        string `json:"type"`
+	HandoffTime string `json:"handoff_time,omitempty"`
+	HandoffDay  string `json:"handoff_day,omitempty"`
+
+	// ShiftDuration is how long each shift of a custom strategy lasts, as an ISO 8601 duration such as PT12H
+	ShiftDuration string `json:"shift_duration,omitempty"`
+}
+
+// ScheduleMember is a user participating in an on-call schedule rotation
+type ScheduleMember struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// ScheduleResponse is the payload for retrieving an on-call schedule
+// URL: GET https://api.firehydrant.io/v1/teams/{team_id}/on_call_schedules/{id}
+type ScheduleResponse struct {
+	ID          string           `json:"id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	TimeZone    string           `json:"time_zone"`
+	Strategy    ScheduleStrategy `json:"strategy"`
+	Members     []ScheduleMember `json:"members"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+}
+
+// CreateScheduleRequest is the payload for creating an on-call schedule
+// URL: POST https://api.firehydrant.io/v1/teams/{team_id}/on_call_schedules
+type CreateScheduleRequest struct {
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	TimeZone    string           `json:"time_zone"`
+	Strategy    ScheduleStrategy `json:"strategy"`
+	MemberIDs   []string         `json:"member_ids"`
+}
+
+// UpdateScheduleRequest is the payload for updating an on-call schedule
+// URL: PATCH https://api.firehydrant.io/v1/teams/{team_id}/on_call_schedules/{id}
+type UpdateScheduleRequest struct {
+	Name        string            `json:"name,omitempty"`
+	Description string            `json:"description,omitempty"`
+	TimeZone    string            `json:"time_zone,omitempty"`
+	Strategy    *ScheduleStrategy `json:"strategy,omitempty"`
+	MemberIDs   []string          `json:"member_ids"`
+}
+
+// OnCallResponse is who is on call right now, for a team across all of its schedules or for a single
+// schedule
+// URL: GET https://api.firehydrant.io/v1/teams/{team_id}/on_call
+// URL: GET https://api.firehydrant.io/v1/teams/{team_id}/on_call_schedules/{id}/on_call
+type OnCallResponse struct {
+	Users []UserResponse `json:"users"`
+}
+
+// SchedulesClient is an interface for interacting with on-call schedules on FireHydrant
+type SchedulesClient interface {
+	Get(ctx context.Context, teamID, id string) (*ScheduleResponse, error)
+	Create(ctx context.Context, teamID string, createReq CreateScheduleRequest) (*ScheduleResponse, error)
+	Update(ctx context.Context, teamID, id string, updateReq UpdateScheduleRequest) (*ScheduleResponse, error)
+	Delete(ctx context.Context, teamID, id string) error
+	OnCall(ctx context.Context, teamID, id string) (*OnCallResponse, error)
+}
+
+// RESTSchedulesClient implements the SchedulesClient interface
+type RESTSchedulesClient struct {
+	client *APIClient
+}
+
+var _ SchedulesClient = &RESTSchedulesClient{}
+
+func (c *RESTSchedulesClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
+}
+
+func schedulePath(teamID string) string {
+	return "teams/" + teamID + "/on_call_schedules"
+}
+
+// Get returns an on-call schedule from the FireHydrant API
+func (c *RESTSchedulesClient) Get(ctx context.Context, teamID, id string) (*ScheduleResponse, error) {
+	res := &ScheduleResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get(schedulePath(teamID)+"/"+id).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get on-call schedule")
+	}
+
+	return res, nil
+}
+
+// Create creates an on-call schedule for a team in FireHydrant
+func (c *RESTSchedulesClient) Create(ctx context.Context, teamID string, createReq CreateScheduleRequest) (*ScheduleResponse, error) {
+	res := &ScheduleResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Post(schedulePath(teamID)).BodyJSON(&createReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not create on-call schedule")
+	}
+
+	return res, nil
+}
+
+// Update updates an on-call schedule in FireHydrant
+func (c *RESTSchedulesClient) Update(ctx context.Context, teamID, id string, updateReq UpdateScheduleRequest) (*ScheduleResponse, error) {
+	res := &ScheduleResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Patch(schedulePath(teamID)+"/"+id).BodyJSON(&updateReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not update on-call schedule")
+	}
+
+	return res, nil
+}
+
+// Delete deletes an on-call schedule from FireHydrant
+func (c *RESTSchedulesClient) Delete(ctx context.Context, teamID, id string) error {
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Delete(schedulePath(teamID)+"/"+id).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not delete on-call schedule")
+	}
+
+	return nil
+}
+
+// OnCall returns who is on call right now for one of a team's schedules, or for every schedule the
+// team has when id is empty
+func (c *RESTSchedulesClient) OnCall(ctx context.Context, teamID, id string) (*OnCallResponse, error) {
+	res := &OnCallResponse{}
+	apiErr := &APIError{}
+
+	path := "teams/" + teamID + "/on_call"
+	if id != "" {
+		path = schedulePath(teamID) + "/" + id + "/on_call"
+	}
+
+	resp, err := c.restClient(ctx).Get(path).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get who is on call")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,104 @@
+package firehydrant
+
+import (
+	"context"
+	"time"
+
+	"github.com/dghubble/sling"
+	"github.com/pkg/errors"
+)
+
+// ServiceDependencyResponse is the payload for retrieving a service dependency
+// URL: GET https://api.firehydrant.io/v1/dependencies/{id}
+type ServiceDependencyResponse struct {
+	ID               string          `json:"id"`
+	Notes            string          `json:"notes"`
+	Service          ServiceResponse `json:"service"`
+	ConnectedService ServiceResponse `json:"connected_service"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+}
+
+// CreateServiceDependencyRequest is the payload for creating a service dependency
+// URL: POST https://api.firehydrant.io/v1/dependencies
+type CreateServiceDependencyRequest struct {
+	ServiceID          string `json:"service_id"`
+	ConnectedServiceID string `json:"connected_service_id"`
+	Notes              string `json:"notes,omitempty"`
+}
+
+// UpdateServiceDependencyRequest is the payload for updating a service dependency
+// URL: PATCH https://api.firehydrant.io/v1/dependencies/{id}
+type UpdateServiceDependencyRequest struct {
+	Notes string `json:"notes"`
+}
+
+// ServiceDependenciesClient is an interface for interacting with service dependencies on FireHydrant
+type ServiceDependenciesClient interface {
+	Get(ctx context.Context, id string) (*ServiceDependencyResponse, error)
+	Create(ctx context.Context, createReq CreateServiceDependencyRequest) (*ServiceDependencyResponse, error)
+	Update(ctx context.Context, id string, updateReq UpdateServiceDependencyRequest) (*ServiceDependencyResponse, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// RESTServiceDependenciesClient implements the ServiceDependenciesClient interface
+type RESTServiceDependenciesClient struct {
+	client *APIClient
+}
+
+var _ ServiceDependenciesClient = &RESTServiceDependenciesClient{}
+
+func (c *RESTServiceDependenciesClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
+}
+
+// Get returns a service dependency from the FireHydrant API
+func (c *RESTServiceDependenciesClient) Get(ctx context.Context, id string) (*ServiceDependencyResponse, error) {
+	res := &ServiceDependencyResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get("dependencies/"+id).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get service dependency")
+	}
+
+	return res, nil
+}
+
+// Create creates a dependency between two services in FireHydrant
+func (c *RESTServiceDependenciesClient) Create(ctx context.Context, createReq CreateServiceDependencyRequest) (*ServiceDependencyResponse, error) {
+	res := &ServiceDependencyResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Post("dependencies").BodyJSON(&createReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not create service dependency")
+	}
+
+	return res, nil
+}
+
+// Update updates a service dependency in FireHydrant
+func (c *RESTServiceDependenciesClient) Update(ctx context.Context, id string, updateReq UpdateServiceDependencyRequest) (*ServiceDependencyResponse, error) {
+	res := &ServiceDependencyResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Patch("dependencies/"+id).BodyJSON(&updateReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not update service dependency")
+	}
+
+	return res, nil
+}
+
+// Delete removes a dependency between two services in FireHydrant
+func (c *RESTServiceDependenciesClient) Delete(ctx context.Context, id string) error {
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Delete("dependencies/"+id).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not delete service dependency")
+	}
+
+	return nil
+}
@@ -2,7 +2,6 @@ package firehydrant
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/dghubble/sling"
 	"github.com/pkg/errors"
@@ -14,7 +13,17 @@ type ServicesClient interface {
 	List(ctx context.Context, req *ServiceQuery) (*ServicesResponse, error)
 	Create(ctx context.Context, req CreateServiceRequest) (*ServiceResponse, error)
 	Update(ctx context.Context, serviceID string, req UpdateServiceRequest) (*ServiceResponse, error)
+	UpdateLinks(ctx context.Context, serviceID string, req UpdateServiceLinksRequest) (*ServiceResponse, error)
+	GetSlackChannel(ctx context.Context, serviceID string) (*ServiceSlackChannel, error)
+	UpdateSlackChannel(ctx context.Context, serviceID string, req ServiceSlackChannel) (*ServiceSlackChannel, error)
+	DeleteSlackChannel(ctx context.Context, serviceID string) error
+	AddEnvironment(ctx context.Context, serviceID, environmentID string) error
+	RemoveEnvironment(ctx context.Context, serviceID, environmentID string) error
+	GetAlertGrouping(ctx context.Context, serviceID string) (*ServiceAlertGrouping, error)
+	UpdateAlertGrouping(ctx context.Context, serviceID string, req ServiceAlertGrouping) (*ServiceAlertGrouping, error)
+	DeleteAlertGrouping(ctx context.Context, serviceID string) error
 	Delete(ctx context.Context, serviceID string) error
+	Destroy(ctx context.Context, serviceID string) error
 }
 
 // RESTServicesClient implements the ServicesClient interface
@@ -24,43 +33,83 @@ type RESTServicesClient struct {
 
 var _ ServicesClient = &RESTServicesClient{}
 
-func (c *RESTServicesClient) restClient() *sling.Sling {
-	return c.client.client()
+func (c *RESTServicesClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
 }
 
 // Get retrieves a service from the FireHydrant API
-// TODO: Check failure case
 func (c *RESTServicesClient) Get(ctx context.Context, id string) (*ServiceResponse, error) {
 	res := &ServiceResponse{}
-	resp, err := c.restClient().Get("services/"+id).Receive(res, nil)
-	if err != nil {
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get("services/"+id).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
 		return nil, errors.Wrap(err, "could not get service")
 	}
 
-	if resp.StatusCode == 404 {
-		return nil, NotFound(fmt.Sprintf("Could not find service with ID %s", id))
+	return res, nil
+}
+
+// List retrieves a list of services based on a service query. If the query does not
+// request a specific page, every page is fetched and the services are combined in page order
+func (c *RESTServicesClient) List(ctx context.Context, req *ServiceQuery) (*ServicesResponse, error) {
+	if req == nil {
+		req = &ServiceQuery{}
+	}
+
+	if req.Page != 0 {
+		return c.listPage(ctx, req)
+	}
+
+	firstReq := *req
+	firstReq.Page = 1
+	res, err := c.listPage(ctx, &firstReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Pagination == nil || res.Pagination.TotalPages <= 1 {
+		return res, nil
+	}
+
+	pages := make([]*ServicesResponse, res.Pagination.TotalPages+1)
+	err = fetchRemainingPages(ctx, res.Pagination.TotalPages, c.client.pageWorkers(), func(ctx context.Context, number int) error {
+		pageReq := *req
+		pageReq.Page = number
+		page, err := c.listPage(ctx, &pageReq)
+		pages[number] = page
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, page := range pages[2:] {
+		res.Services = append(res.Services, page.Services...)
 	}
 
 	return res, nil
 }
 
-// List retrieves a list of services based on a service query
-func (c *RESTServicesClient) List(ctx context.Context, req *ServiceQuery) (*ServicesResponse, error) {
+func (c *RESTServicesClient) listPage(ctx context.Context, req *ServiceQuery) (*ServicesResponse, error) {
 	res := &ServicesResponse{}
-	_, err := c.restClient().Get("services").QueryStruct(req).Receive(res, nil)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not get service")
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get("services").QueryStruct(req).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get services")
 	}
 
 	return res, nil
 }
 
 // Create creates a brand spankin new service in FireHydrant
-// TODO: Check failure case
 func (c *RESTServicesClient) Create(ctx context.Context, createReq CreateServiceRequest) (*ServiceResponse, error) {
 	res := &ServiceResponse{}
+	apiErr := &APIError{}
 
-	if _, err := c.restClient().Post("services").BodyJSON(&createReq).Receive(res, nil); err != nil {
+	resp, err := c.restClient(ctx).Post("services").BodyJSON(&createReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
 		return nil, errors.Wrap(err, "could not create service")
 	}
 
@@ -68,23 +117,160 @@ func (c *RESTServicesClient) Create(ctx context.Context, createReq CreateService
 }
 
 // UpdateService updates a old spankin service in FireHydrant
-// TODO: Check failure case
 func (c *RESTServicesClient) Update(ctx context.Context, serviceID string, updateReq UpdateServiceRequest) (*ServiceResponse, error) {
 	res := &ServiceResponse{}
+	apiErr := &APIError{}
 
-	if _, err := c.restClient().Patch("services/"+serviceID).BodyJSON(&updateReq).Receive(res, nil); err != nil {
+	resp, err := c.restClient(ctx).Patch("services/"+serviceID).BodyJSON(&updateReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
 		return nil, errors.Wrap(err, "could not update service")
 	}
 
 	return res, nil
 }
 
-// DeleteService updates a old spankin service in FireHydrant
-// TODO: Check failure case
+// UpdateLinks replaces every link on a service with the links in the request
+func (c *RESTServicesClient) UpdateLinks(ctx context.Context, serviceID string, updateReq UpdateServiceLinksRequest) (*ServiceResponse, error) {
+	res := &ServiceResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Patch("services/"+serviceID).BodyJSON(&updateReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not update service links")
+	}
+
+	return res, nil
+}
+
+// GetSlackChannel returns the Slack channel a service is linked to
+func (c *RESTServicesClient) GetSlackChannel(ctx context.Context, serviceID string) (*ServiceSlackChannel, error) {
+	res := &ServiceSlackChannel{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get("services/"+serviceID+"/slack_channel").Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get service slack channel")
+	}
+
+	return res, nil
+}
+
+// UpdateSlackChannel links a service to a Slack channel, replacing any channel it was linked to before
+func (c *RESTServicesClient) UpdateSlackChannel(ctx context.Context, serviceID string, updateReq ServiceSlackChannel) (*ServiceSlackChannel, error) {
+	res := &ServiceSlackChannel{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Put("services/"+serviceID+"/slack_channel").BodyJSON(&updateReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not update service slack channel")
+	}
+
+	return res, nil
+}
+
+// DeleteSlackChannel unlinks a service from its Slack channel. The channel itself is left in Slack
+// URL: DELETE https://api.firehydrant.io/v1/services/{id}/slack_channel
+func (c *RESTServicesClient) DeleteSlackChannel(ctx context.Context, serviceID string) error {
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Delete("services/"+serviceID+"/slack_channel").Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not delete service slack channel")
+	}
+
+	return nil
+}
+
+// AddEnvironment records that a service runs in an environment
+// URL: POST https://api.firehydrant.io/v1/services/{id}/environments
+func (c *RESTServicesClient) AddEnvironment(ctx context.Context, serviceID, environmentID string) error {
+	apiErr := &APIError{}
+	req := struct {
+		EnvironmentID string `json:"environment_id"`
+	}{EnvironmentID: environmentID}
+
+	resp, err := c.restClient(ctx).Post("services/"+serviceID+"/environments").BodyJSON(&req).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not add service environment")
+	}
+
+	return nil
+}
+
+// RemoveEnvironment records that a service no longer runs in an environment. The environment itself is kept
+// URL: DELETE https://api.firehydrant.io/v1/services/{id}/environments/{environment_id}
+func (c *RESTServicesClient) RemoveEnvironment(ctx context.Context, serviceID, environmentID string) error {
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Delete("services/"+serviceID+"/environments/"+environmentID).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not remove service environment")
+	}
+
+	return nil
+}
+
+// GetAlertGrouping returns how alerts for a service are grouped
+func (c *RESTServicesClient) GetAlertGrouping(ctx context.Context, serviceID string) (*ServiceAlertGrouping, error) {
+	res := &ServiceAlertGrouping{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get("services/"+serviceID+"/alert_grouping").Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get service alert grouping")
+	}
+
+	return res, nil
+}
+
+// UpdateAlertGrouping replaces how alerts for a service are grouped
+func (c *RESTServicesClient) UpdateAlertGrouping(ctx context.Context, serviceID string, updateReq ServiceAlertGrouping) (*ServiceAlertGrouping, error) {
+	res := &ServiceAlertGrouping{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Put("services/"+serviceID+"/alert_grouping").BodyJSON(&updateReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not update service alert grouping")
+	}
+
+	return res, nil
+}
+
+// DeleteAlertGrouping stops grouping alerts for a service, so that every alert is handled on its own
+// URL: DELETE https://api.firehydrant.io/v1/services/{id}/alert_grouping
+func (c *RESTServicesClient) DeleteAlertGrouping(ctx context.Context, serviceID string) error {
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Delete("services/"+serviceID+"/alert_grouping").Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not delete service alert grouping")
+	}
+
+	return nil
+}
+
+// Delete archives a service in FireHydrant, which keeps its incident history
+// URL: DELETE https://api.firehydrant.io/v1/services/{id}
 func (c *RESTServicesClient) Delete(ctx context.Context, serviceID string) error {
-	if _, err := c.restClient().Delete("services/"+serviceID).Receive(nil, nil); err != nil {
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Delete("services/"+serviceID).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
 		return errors.Wrap(err, "could not delete service")
 	}
 
 	return nil
 }
+
+// Destroy permanently deletes a service in FireHydrant, along with its incident history
+// URL: DELETE https://api.firehydrant.io/v1/services/{id}/destroy
+func (c *RESTServicesClient) Destroy(ctx context.Context, serviceID string) error {
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Delete("services/"+serviceID+"/destroy").Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not destroy service")
+	}
+
+	return nil
+}
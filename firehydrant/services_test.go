@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/google/go-querystring/query"
@@ -39,6 +41,102 @@ func TestCreateService(t *testing.T) {
 	require.NoError(t, err, "error creating a service")
 }
 
+func TestUpdateServiceOwner(t *testing.T) {
+	resp := &ServiceResponse{}
+	testServiceID := "test-service-id"
+	req := UpdateServiceRequest{Name: String("fake-service"), Owner: &ServiceTeam{ID: "test-team-id"}}
+	c, teardown, err := setupClient("/services/"+testServiceID, resp,
+		AssertRequestJSONBody(t, map[string]interface{}{"name": "fake-service", "owner": map[string]string{"id": "test-team-id"}}),
+		AssertRequestMethod(t, "PATCH"),
+	)
+
+	require.NoError(t, err)
+	defer teardown()
+
+	_, err = c.Services().Update(context.TODO(), testServiceID, req)
+	require.NoError(t, err, "error updating a service owner")
+}
+
+func TestUpdateServiceAlertOnAddFalse(t *testing.T) {
+	resp := &ServiceResponse{}
+	testServiceID := "test-service-id"
+	c, teardown, err := setupClient("/services/"+testServiceID, resp,
+		AssertRequestJSONBody(t, struct {
+			Name       string `json:"name"`
+			AlertOnAdd bool   `json:"alert_on_add"`
+		}{Name: "fake-service"}),
+		AssertRequestMethod(t, "PATCH"),
+	)
+
+	require.NoError(t, err)
+	defer teardown()
+
+	_, err = c.Services().Update(context.TODO(), testServiceID, UpdateServiceRequest{Name: String("fake-service"), AlertOnAdd: Bool(false)})
+	require.NoError(t, err, "error turning off alert on add")
+}
+
+func TestCreateServiceAlertOnAdd(t *testing.T) {
+	unset, err := json.Marshal(CreateServiceRequest{Name: "fake-service"})
+	require.NoError(t, err)
+	assert.NotContains(t, string(unset), "alert_on_add", "an unset alert on add should be left to FireHydrant")
+
+	off, err := json.Marshal(CreateServiceRequest{Name: "fake-service", AlertOnAdd: Bool(false)})
+	require.NoError(t, err)
+	assert.Contains(t, string(off), `"alert_on_add":false`, "an explicit false should be sent")
+}
+
+func TestUpdateServiceTier(t *testing.T) {
+	unset, err := json.Marshal(UpdateServiceRequest{Name: String("fake-service")})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name": "fake-service"}`, string(unset), "an unset tier should leave the service's tier alone")
+
+	cleared, err := json.Marshal(UpdateServiceRequest{Name: String("fake-service"), ServiceTier: Int(0)})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name": "fake-service", "service_tier": 0}`, string(cleared), "a tier of 0 should be sent to clear the tier")
+
+	tiered, err := json.Marshal(CreateServiceRequest{Name: "fake-service", ServiceTier: Int(2)})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name": "fake-service", "description": "", "service_tier": 2}`, string(tiered))
+}
+
+func TestUpdateServiceLinks(t *testing.T) {
+	resp := &ServiceResponse{}
+	testServiceID := "test-service-id"
+	req := UpdateServiceLinksRequest{
+		Links: []ServiceLink{
+			{ID: "existing-link-id", Name: "Dashboard", HrefURL: "https://example.com/dashboard"},
+			{Name: "Runbook", HrefURL: "https://example.com/runbook"},
+		},
+	}
+	c, teardown, err := setupClient("/services/"+testServiceID, resp,
+		AssertRequestJSONBody(t, req),
+		AssertRequestMethod(t, "PATCH"),
+	)
+
+	require.NoError(t, err)
+	defer teardown()
+
+	_, err = c.Services().UpdateLinks(context.TODO(), testServiceID, req)
+	require.NoError(t, err, "error updating service links")
+}
+
+func TestUpdateServiceLinksEmpty(t *testing.T) {
+	resp := &ServiceResponse{}
+	testServiceID := "test-service-id"
+	var body map[string]interface{}
+	c, teardown, err := setupClient("/services/"+testServiceID, resp, func(req *http.Request) {
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+	})
+
+	require.NoError(t, err)
+	defer teardown()
+
+	// Removing the last link has to send an empty list rather than leave links out
+	_, err = c.Services().UpdateLinks(context.TODO(), testServiceID, UpdateServiceLinksRequest{Links: []ServiceLink{}})
+	require.NoError(t, err, "error updating service links")
+	assert.Equal(t, map[string]interface{}{"links": []interface{}{}}, body)
+}
+
 func TestGetServices(t *testing.T) {
 	var requestPathRcvd string
 	response := ServicesResponse{
@@ -88,7 +186,158 @@ func TestGetServices(t *testing.T) {
 		t.Fatalf("Received error hitting ping endpoint: %s", err.Error())
 	}
 
-	if expected := "/services?labels=key1%3Dval1%2Ckey2%3Dval2&query=hello-world"; expected != requestPathRcvd {
+	if expected := "/services?labels=key1%3Dval1%2Ckey2%3Dval2&page=1&query=hello-world"; expected != requestPathRcvd {
 		t.Fatalf("Expected %s, Got: %s for request path", expected, requestPathRcvd)
 	}
 }
+
+func TestGetServicesPaginated(t *testing.T) {
+	var pagesRequested []string
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		page := req.URL.Query().Get("page")
+		pagesRequested = append(pagesRequested, page)
+
+		response := ServicesResponse{
+			Services:   []ServiceResponse{{ID: "service-page-" + page}},
+			Pagination: &Pagination{TotalPages: 2, TotalCount: 2, PerPage: 1},
+		}
+
+		if err := json.NewEncoder(w).Encode(&response); err != nil {
+			panic(err)
+		}
+	})
+	ts := httptest.NewServer(h)
+
+	defer ts.Close()
+
+	c, err := NewRestClient("testing-123", WithBaseURL(ts.URL))
+	require.NoError(t, err)
+
+	res, err := c.Services().List(context.TODO(), &ServiceQuery{})
+	require.NoError(t, err, "error listing services")
+
+	assert.Equal(t, []string{"1", "2"}, pagesRequested)
+	require.Len(t, res.Services, 2)
+	assert.Equal(t, "service-page-1", res.Services[0].ID)
+	assert.Equal(t, "service-page-2", res.Services[1].ID)
+
+	pagesRequested = nil
+	res, err = c.Services().List(context.TODO(), &ServiceQuery{Page: 2})
+	require.NoError(t, err, "error listing a single page of services")
+
+	assert.Equal(t, []string{"2"}, pagesRequested)
+	require.Len(t, res.Services, 1)
+	assert.Equal(t, "service-page-2", res.Services[0].ID)
+}
+
+func TestServiceQueryLabels(t *testing.T) {
+	cases := map[string]struct {
+		query    ServiceQuery
+		expected url.Values
+	}{
+		"all labels": {
+			query: ServiceQuery{
+				LabelsSelector: LabelsSelector{"key1": "val1", "key2": "val2"},
+				LabelsMatch:    LabelsMatchAll,
+			},
+			expected: url.Values{"labels": {"key1=val1,key2=val2"}, "labels_match": {"all"}},
+		},
+		"any label": {
+			query: ServiceQuery{
+				LabelsSelector: LabelsSelector{"key1": "val1", "key2": "val2"},
+				LabelsMatch:    LabelsMatchAny,
+			},
+			expected: url.Values{"labels": {"key1=val1,key2=val2"}, "labels_match": {"any"}},
+		},
+		"no match mode": {
+			query: ServiceQuery{
+				LabelsSelector: LabelsSelector{"key1": "val1"},
+			},
+			expected: url.Values{"labels": {"key1=val1"}},
+		},
+		"value with commas and equals signs": {
+			query: ServiceQuery{
+				LabelsSelector: LabelsSelector{"region": "us-east-1,us-west-2", "owner": "team=sre"},
+			},
+			expected: url.Values{"labels": {"owner=team%3Dsre,region=us-east-1%2Cus-west-2"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			vs, err := query.Values(tc.query)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, vs)
+		})
+	}
+}
+
+func TestLabelsSelectorRoundTrip(t *testing.T) {
+	labels := LabelsSelector{
+		"region":         "us-east-1,us-west-2",
+		"owner":          "team=sre",
+		"key=with,both":  "value with spaces & ampersands",
+		"percent%encode": "100%",
+	}
+
+	vs, err := query.Values(ServiceQuery{LabelsSelector: labels})
+	require.NoError(t, err)
+
+	// Go through a real query string so both layers of encoding are exercised
+	parsed, err := url.ParseQuery(vs.Encode())
+	require.NoError(t, err)
+
+	decoded := LabelsSelector{}
+	for _, pair := range strings.Split(parsed.Get("labels"), ",") {
+		kv := strings.SplitN(pair, "=", 2)
+		require.Len(t, kv, 2, "label %q was not a key=value pair", pair)
+
+		k, err := url.QueryUnescape(kv[0])
+		require.NoError(t, err)
+		v, err := url.QueryUnescape(kv[1])
+		require.NoError(t, err)
+
+		decoded[k] = v
+	}
+
+	assert.Equal(t, labels, decoded)
+}
+
+func TestDeleteService(t *testing.T) {
+	testServiceID := "test-service-id"
+	c, teardown, err := setupClient("/services/"+testServiceID, &ServiceResponse{},
+		AssertRequestMethod(t, "DELETE"),
+	)
+	require.NoError(t, err)
+	defer teardown()
+
+	err = c.Services().Delete(context.TODO(), testServiceID)
+	require.NoError(t, err, "error archiving a service")
+}
+
+func TestDestroyService(t *testing.T) {
+	testServiceID := "test-service-id"
+	c, teardown, err := setupClient("/services/"+testServiceID+"/destroy", &ServiceResponse{},
+		AssertRequestMethod(t, "DELETE"),
+	)
+	require.NoError(t, err)
+	defer teardown()
+
+	err = c.Services().Destroy(context.TODO(), testServiceID)
+	require.NoError(t, err, "error destroying a service")
+}
+
+func TestUpdateServiceSlackChannel(t *testing.T) {
+	resp := &ServiceSlackChannel{}
+	req := ServiceSlackChannel{ChannelName: "svc-api", AutoCreate: true}
+	c, teardown, err := setupClient("/services/test-service-id/slack_channel", resp,
+		AssertRequestJSONBody(t, req),
+		AssertRequestMethod(t, "PUT"),
+	)
+	require.NoError(t, err)
+	defer teardown()
+
+	_, err = c.Services().UpdateSlackChannel(context.TODO(), "test-service-id", req)
+	require.NoError(t, err, "error updating a service's slack channel")
+}
@@ -0,0 +1,78 @@
+package firehydrant
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListSeverities(t *testing.T) {
+	var pagesRequested []string
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		page := req.URL.Query().Get("page")
+		pagesRequested = append(pagesRequested, page)
+
+		// Severities only come back with a color when one has been picked
+		response := `{"data": [{"slug": "SEV` + page + `", "description": "", "type": "unexpected_downtime"}], "pagination": {"pages": 2}}`
+		if page == "2" {
+			response = `{"data": [{"slug": "MAINT", "type": "maintenance", "color": "#0000ff"}], "pagination": {"pages": 2}}`
+		}
+
+		w.Write([]byte(response))
+	})
+	ts := httptest.NewServer(h)
+
+	defer ts.Close()
+
+	c, err := NewRestClient("testing-123", WithBaseURL(ts.URL))
+	require.NoError(t, err)
+
+	res, err := c.ListSeverities(context.TODO())
+	require.NoError(t, err, "error listing severities")
+
+	assert.Equal(t, []string{"1", "2"}, pagesRequested)
+	assert.Equal(t, []SeverityResponse{
+		{Slug: "SEV1", Type: "unexpected_downtime"},
+		{Slug: "MAINT", Type: "maintenance", Color: "#0000ff"},
+	}, res.Severities)
+}
+
+func TestCreateSeverityOmitsUnsetFields(t *testing.T) {
+	resp := &SeverityResponse{}
+	c, teardown, err := setupClient("/severities", resp,
+		AssertRequestJSONBody(t, struct {
+			Slug        string `json:"slug"`
+			Description string `json:"description"`
+		}{Slug: "SEV1"}),
+		AssertRequestMethod(t, "POST"),
+	)
+
+	require.NoError(t, err)
+	defer teardown()
+
+	// Leaving out type and color lets FireHydrant fill them in
+	_, err = c.CreateSeverity(context.TODO(), CreateSeverityRequest{Slug: "SEV1"})
+	require.NoError(t, err, "error creating a severity")
+}
+
+func TestUpdateSeverityClearsDefaultPriority(t *testing.T) {
+	resp := &SeverityResponse{}
+	c, teardown, err := setupClient("/severities/SEV1", resp,
+		AssertRequestJSONBody(t, struct {
+			Slug            string `json:"slug"`
+			DefaultPriority string `json:"default_priority"`
+		}{Slug: "SEV1"}),
+		AssertRequestMethod(t, "PATCH"),
+	)
+
+	require.NoError(t, err)
+	defer teardown()
+
+	_, err = c.UpdateSeverity(context.TODO(), "SEV1", UpdateSeverityRequest{Slug: "SEV1", DefaultPriority: String("")})
+	require.NoError(t, err, "error updating a severity")
+}
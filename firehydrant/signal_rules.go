@@ -0,0 +1,129 @@
+package firehydrant
+
+import (
+	"context"
+	"time"
+
+	"github.com/dghubble/sling"
+	"github.com/pkg/errors"
+)
+
+// SignalRuleTarget is who an alert matching a signal rule is routed to
+type SignalRuleTarget struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// SignalRuleResponse is the payload for retrieving a signal rule. A team's rules are matched in order of
+// their position, starting from 1, and an alert is routed by the first rule it matches
+// URL: GET https://api.firehydrant.io/v1/teams/{team_id}/signal_rules/{id}
+type SignalRuleResponse struct {
+	ID                          string           `json:"id"`
+	Name                        string           `json:"name"`
+	Expression                  string           `json:"expression"`
+	Target                      SignalRuleTarget `json:"target"`
+	IncidentTypeID              string           `json:"incident_type_id"`
+	CreateIncidentConditionWhen string           `json:"create_incident_condition_when"`
+	Position                    int              `json:"position"`
+	CreatedAt                   time.Time        `json:"created_at"`
+	UpdatedAt                   time.Time        `json:"updated_at"`
+}
+
+// CreateSignalRuleRequest is the payload for creating a signal rule
+// URL: POST https://api.firehydrant.io/v1/teams/{team_id}/signal_rules
+type CreateSignalRuleRequest struct {
+	Name                        string           `json:"name"`
+	Expression                  string           `json:"expression"`
+	Target                      SignalRuleTarget `json:"target"`
+	IncidentTypeID              string           `json:"incident_type_id,omitempty"`
+	CreateIncidentConditionWhen string           `json:"create_incident_condition_when,omitempty"`
+	Position                    int              `json:"position,omitempty"`
+}
+
+// UpdateSignalRuleRequest is the payload for updating a signal rule
+// URL: PATCH https://api.firehydrant.io/v1/teams/{team_id}/signal_rules/{id}
+type UpdateSignalRuleRequest struct {
+	Name                        string           `json:"name,omitempty"`
+	Expression                  string           `json:"expression,omitempty"`
+	Target                      SignalRuleTarget `json:"target"`
+	IncidentTypeID              string           `json:"incident_type_id"`
+	CreateIncidentConditionWhen string           `json:"create_incident_condition_when,omitempty"`
+
+	// Position moves the rule among the team's rules, shifting the others, and is left unchanged when
+	// it is nil
+	Position *int `json:"position,omitempty"`
+}
+
+// SignalRulesClient is an interface for interacting with signal rules on FireHydrant
+type SignalRulesClient interface {
+	Get(ctx context.Context, teamID, id string) (*SignalRuleResponse, error)
+	Create(ctx context.Context, teamID string, createReq CreateSignalRuleRequest) (*SignalRuleResponse, error)
+	Update(ctx context.Context, teamID, id string, updateReq UpdateSignalRuleRequest) (*SignalRuleResponse, error)
+	Delete(ctx context.Context, teamID, id string) error
+}
+
+// RESTSignalRulesClient implements the SignalRulesClient interface
+type RESTSignalRulesClient struct {
+	client *APIClient
+}
+
+var _ SignalRulesClient = &RESTSignalRulesClient{}
+
+func (c *RESTSignalRulesClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
+}
+
+func signalRulePath(teamID string) string {
+	return "teams/" + teamID + "/signal_rules"
+}
+
+// Get returns a signal rule from the FireHydrant API
+func (c *RESTSignalRulesClient) Get(ctx context.Context, teamID, id string) (*SignalRuleResponse, error) {
+	res := &SignalRuleResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get(signalRulePath(teamID)+"/"+id).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get signal rule")
+	}
+
+	return res, nil
+}
+
+// Create creates a signal rule for a team in FireHydrant
+func (c *RESTSignalRulesClient) Create(ctx context.Context, teamID string, createReq CreateSignalRuleRequest) (*SignalRuleResponse, error) {
+	res := &SignalRuleResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Post(signalRulePath(teamID)).BodyJSON(&createReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not create signal rule")
+	}
+
+	return res, nil
+}
+
+// Update updates a signal rule in FireHydrant
+func (c *RESTSignalRulesClient) Update(ctx context.Context, teamID, id string, updateReq UpdateSignalRuleRequest) (*SignalRuleResponse, error) {
+	res := &SignalRuleResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Patch(signalRulePath(teamID)+"/"+id).BodyJSON(&updateReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not update signal rule")
+	}
+
+	return res, nil
+}
+
+// Delete deletes a signal rule from FireHydrant
+func (c *RESTSignalRulesClient) Delete(ctx context.Context, teamID, id string) error {
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Delete(signalRulePath(teamID)+"/"+id).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not delete signal rule")
+	}
+
+	return nil
+}
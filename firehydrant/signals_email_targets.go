@@ -0,0 +1,108 @@
+package firehydrant
+
+import (
+	"context"
+	"time"
+
+	"github.com/dghubble/sling"
+	"github.com/pkg/errors"
+)
+
+// SignalsEmailTargetResponse is the payload for retrieving a signals email target
+// URL: GET https://api.firehydrant.io/v1/signals/email_targets/{id}
+type SignalsEmailTargetResponse struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	TeamID      string    `json:"team_id"`
+	Email       string    `json:"email"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CreateSignalsEmailTargetRequest is the payload for creating a signals email target. FireHydrant
+// generates the email address
+// URL: POST https://api.firehydrant.io/v1/signals/email_targets
+type CreateSignalsEmailTargetRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	TeamID      string `json:"team_id"`
+}
+
+// UpdateSignalsEmailTargetRequest is the payload for updating a signals email target
+// URL: PATCH https://api.firehydrant.io/v1/signals/email_targets/{id}
+type UpdateSignalsEmailTargetRequest struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description"`
+	TeamID      string `json:"team_id,omitempty"`
+}
+
+// SignalsEmailTargetsClient is an interface for interacting with signals email targets on FireHydrant
+type SignalsEmailTargetsClient interface {
+	Get(ctx context.Context, id string) (*SignalsEmailTargetResponse, error)
+	Create(ctx context.Context, createReq CreateSignalsEmailTargetRequest) (*SignalsEmailTargetResponse, error)
+	Update(ctx context.Context, id string, updateReq UpdateSignalsEmailTargetRequest) (*SignalsEmailTargetResponse, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// RESTSignalsEmailTargetsClient implements the SignalsEmailTargetsClient interface
+type RESTSignalsEmailTargetsClient struct {
+	client *APIClient
+}
+
+var _ SignalsEmailTargetsClient = &RESTSignalsEmailTargetsClient{}
+
+func (c *RESTSignalsEmailTargetsClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
+}
+
+// Get returns a signals email target from the FireHydrant API
+func (c *RESTSignalsEmailTargetsClient) Get(ctx context.Context, id string) (*SignalsEmailTargetResponse, error) {
+	res := &SignalsEmailTargetResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get("signals/email_targets/"+id).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get signals email target")
+	}
+
+	return res, nil
+}
+
+// Create creates a signals email target in FireHydrant
+func (c *RESTSignalsEmailTargetsClient) Create(ctx context.Context, createReq CreateSignalsEmailTargetRequest) (*SignalsEmailTargetResponse, error) {
+	res := &SignalsEmailTargetResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Post("signals/email_targets").BodyJSON(&createReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not create signals email target")
+	}
+
+	return res, nil
+}
+
+// Update updates a signals email target in FireHydrant
+func (c *RESTSignalsEmailTargetsClient) Update(ctx context.Context, id string, updateReq UpdateSignalsEmailTargetRequest) (*SignalsEmailTargetResponse, error) {
+	res := &SignalsEmailTargetResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Patch("signals/email_targets/"+id).BodyJSON(&updateReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not update signals email target")
+	}
+
+	return res, nil
+}
+
+// Delete deletes a signals email target from FireHydrant, after which its email address no longer accepts alerts
+func (c *RESTSignalsEmailTargetsClient) Delete(ctx context.Context, id string) error {
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Delete("signals/email_targets/"+id).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not delete signals email target")
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package firehydrant
+
+import (
+	"context"
+	"time"
+
+	"github.com/dghubble/sling"
+	"github.com/pkg/errors"
+)
+
+// StatusPageComponentResponse is the payload for retrieving a component of a status page
+// URL: GET https://api.firehydrant.io/v1/status_pages/{status_page_id}/components/{id}
+type StatusPageComponentResponse struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+
+	// GroupName is the heading the component is shown under, if any
+	GroupName string `json:"group_name"`
+	Position  int    `json:"position"`
+
+	// InfrastructureType is either service or functionality, whichever InfrastructureID refers to
+	InfrastructureType string `json:"infrastructure_type"`
+	InfrastructureID   string `json:"infrastructure_id"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// StatusPageComponentsResponse is the payload for listing the components of a status page
+// URL: GET https://api.firehydrant.io/v1/status_pages/{status_page_id}/components
+type StatusPageComponentsResponse struct {
+	Components []StatusPageComponentResponse `json:"data"`
+}
+
+// CreateStatusPageComponentRequest is the payload for adding a component to a status page
+// URL: POST https://api.firehydrant.io/v1/status_pages/{status_page_id}/components
+type CreateStatusPageComponentRequest struct {
+	Name               string `json:"name"`
+	GroupName          string `json:"group_name,omitempty"`
+	Position           int    `json:"position,omitempty"`
+	InfrastructureType string `json:"infrastructure_type"`
+	InfrastructureID   string `json:"infrastructure_id"`
+}
+
+// UpdateStatusPageComponentRequest is the payload for updating a component of a status page
+// URL: PATCH https://api.firehydrant.io/v1/status_pages/{status_page_id}/components/{id}
+type UpdateStatusPageComponentRequest struct {
+	Name      string `json:"name,omitempty"`
+	GroupName string `json:"group_name"`
+	Position  int    `json:"position,omitempty"`
+}
+
+// StatusPageComponentsClient is an interface for interacting with the components of status pages on FireHydrant
+type StatusPageComponentsClient interface {
+	Get(ctx context.Context, statusPageID, id string) (*StatusPageComponentResponse, error)
+	List(ctx context.Context, statusPageID string) (*StatusPageComponentsResponse, error)
+	Create(ctx context.Context, statusPageID string, createReq CreateStatusPageComponentRequest) (*StatusPageComponentResponse, error)
+	Update(ctx context.Context, statusPageID, id string, updateReq UpdateStatusPageComponentRequest) (*StatusPageComponentResponse, error)
+	Delete(ctx context.Context, statusPageID, id string) error
+}
+
+// RESTStatusPageComponentsClient implements the StatusPageComponentsClient interface
+type RESTStatusPageComponentsClient struct {
+	client *APIClient
+}
+
+var _ StatusPageComponentsClient = &RESTStatusPageComponentsClient{}
+
+func (c *RESTStatusPageComponentsClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
+}
+
+func statusPageComponentPath(statusPageID string) string {
+	return "status_pages/" + statusPageID + "/components"
+}
+
+// Get returns a status page component from the FireHydrant API
+func (c *RESTStatusPageComponentsClient) Get(ctx context.Context, statusPageID, id string) (*StatusPageComponentResponse, error) {
+	res := &StatusPageComponentResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get(statusPageComponentPath(statusPageID)+"/"+id).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get status page component")
+	}
+
+	return res, nil
+}
+
+// List returns every component of a status page from the FireHydrant API
+func (c *RESTStatusPageComponentsClient) List(ctx context.Context, statusPageID string) (*StatusPageComponentsResponse, error) {
+	res := &StatusPageComponentsResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get(statusPageComponentPath(statusPageID)).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not list status page components")
+	}
+
+	return res, nil
+}
+
+// Create adds a component to a status page in FireHydrant
+func (c *RESTStatusPageComponentsClient) Create(ctx context.Context, statusPageID string, createReq CreateStatusPageComponentRequest) (*StatusPageComponentResponse, error) {
+	res := &StatusPageComponentResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Post(statusPageComponentPath(statusPageID)).BodyJSON(&createReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not create status page component")
+	}
+
+	return res, nil
+}
+
+// Update updates a status page component in FireHydrant
+func (c *RESTStatusPageComponentsClient) Update(ctx context.Context, statusPageID, id string, updateReq UpdateStatusPageComponentRequest) (*StatusPageComponentResponse, error) {
+	res := &StatusPageComponentResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Patch(statusPageComponentPath(statusPageID)+"/"+id).BodyJSON(&updateReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not update status page component")
+	}
+
+	return res, nil
+}
+
+// Delete removes a component from a status page in FireHydrant
+func (c *RESTStatusPageComponentsClient) Delete(ctx context.Context, statusPageID, id string) error {
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Delete(statusPageComponentPath(statusPageID)+"/"+id).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not delete status page component")
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package firehydrant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateStatusPageComponent(t *testing.T) {
+	resp := &StatusPageComponentResponse{}
+	req := CreateStatusPageComponentRequest{
+		Name:               "API",
+		GroupName:          "Core",
+		InfrastructureType: "service",
+		InfrastructureID:   "test-service-id",
+	}
+	c, teardown, err := setupClient("/status_pages/test-page-id/components", resp,
+		AssertRequestJSONBody(t, req),
+		AssertRequestMethod(t, "POST"),
+	)
+
+	require.NoError(t, err)
+	defer teardown()
+
+	res, err := c.StatusPageComponents().Create(context.TODO(), "test-page-id", req)
+	require.NoError(t, err, "error creating a status page component")
+	assert.Equal(t, resp.ID, res.ID, "returned component did not match")
+}
+
+func TestListStatusPageComponents(t *testing.T) {
+	resp := &StatusPageComponentsResponse{}
+	c, teardown, err := setupClient("/status_pages/test-page-id/components", resp, AssertRequestMethod(t, "GET"))
+	require.NoError(t, err)
+	defer teardown()
+
+	res, err := c.StatusPageComponents().List(context.TODO(), "test-page-id")
+	require.NoError(t, err, "error listing status page components")
+	assert.Equal(t, len(resp.Components), len(res.Components), "returned components did not match")
+}
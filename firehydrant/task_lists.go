@@ -0,0 +1,112 @@
+package firehydrant
+
+import (
+	"context"
+	"time"
+
+	"github.com/dghubble/sling"
+	"github.com/pkg/errors"
+)
+
+// TaskListItem is a single task in a task list
+type TaskListItem struct {
+	Summary     string `json:"summary"`
+	Description string `json:"description"`
+}
+
+// TaskListResponse is the payload for retrieving a task list
+// URL: GET https://api.firehydrant.io/v1/task_lists/{id}
+type TaskListResponse struct {
+	ID            string         `json:"id"`
+	Name          string         `json:"name"`
+	Description   string         `json:"description"`
+	TaskListItems []TaskListItem `json:"task_list_items"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+}
+
+// CreateTaskListRequest is the payload for creating a task list
+// URL: POST https://api.firehydrant.io/v1/task_lists
+type CreateTaskListRequest struct {
+	Name          string         `json:"name"`
+	Description   string         `json:"description"`
+	TaskListItems []TaskListItem `json:"task_list_items"`
+}
+
+// UpdateTaskListRequest is the payload for updating a task list
+// URL: PATCH https://api.firehydrant.io/v1/task_lists/{id}
+type UpdateTaskListRequest struct {
+	Name          string         `json:"name,omitempty"`
+	Description   string         `json:"description"`
+	TaskListItems []TaskListItem `json:"task_list_items"`
+}
+
+// TaskListsClient is an interface for interacting with task lists on FireHydrant
+type TaskListsClient interface {
+	Get(ctx context.Context, id string) (*TaskListResponse, error)
+	Create(ctx context.Context, createReq CreateTaskListRequest) (*TaskListResponse, error)
+	Update(ctx context.Context, id string, updateReq UpdateTaskListRequest) (*TaskListResponse, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// RESTTaskListsClient implements the TaskListsClient interface
+type RESTTaskListsClient struct {
+	client *APIClient
+}
+
+var _ TaskListsClient = &RESTTaskListsClient{}
+
+func (c *RESTTaskListsClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
+}
+
+// Get returns a task list from the FireHydrant API
+func (c *RESTTaskListsClient) Get(ctx context.Context, id string) (*TaskListResponse, error) {
+	res := &TaskListResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get("task_lists/"+id).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get task list")
+	}
+
+	return res, nil
+}
+
+// Create creates a task list in FireHydrant
+func (c *RESTTaskListsClient) Create(ctx context.Context, createReq CreateTaskListRequest) (*TaskListResponse, error) {
+	res := &TaskListResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Post("task_lists").BodyJSON(&createReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not create task list")
+	}
+
+	return res, nil
+}
+
+// Update updates a task list in FireHydrant
+func (c *RESTTaskListsClient) Update(ctx context.Context, id string, updateReq UpdateTaskListRequest) (*TaskListResponse, error) {
+	res := &TaskListResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Patch("task_lists/"+id).BodyJSON(&updateReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not update task list")
+	}
+
+	return res, nil
+}
+
+// Delete deletes a task list from FireHydrant
+func (c *RESTTaskListsClient) Delete(ctx context.Context, id string) error {
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Delete("task_lists/"+id).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not delete task list")
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package firehydrant
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTeamMembership(t *testing.T) {
+	req := TeamMembership{UserID: "test-user-id", Role: "responder"}
+	c, teardown, err := setupClient("/teams/test-team-id/memberships", &struct{}{},
+		AssertRequestJSONBody(t, req),
+		AssertRequestMethod(t, "POST"),
+	)
+
+	require.NoError(t, err)
+	defer teardown()
+
+	err = c.CreateTeamMembership(context.TODO(), "test-team-id", req)
+	require.NoError(t, err, "error creating a team membership")
+}
+
+func TestDeleteTeamMembership(t *testing.T) {
+	c, teardown, err := setupClient("/teams/test-team-id/memberships/test-user-id", &struct{}{},
+		AssertRequestMethod(t, "DELETE"),
+	)
+
+	require.NoError(t, err)
+	defer teardown()
+
+	err = c.DeleteTeamMembership(context.TODO(), "test-team-id", "test-user-id")
+	require.NoError(t, err, "error deleting a team membership")
+}
+
+func TestListTeamsPaginated(t *testing.T) {
+	var requests []string
+	var mu sync.Mutex
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		requests = append(requests, req.URL.RawQuery)
+		mu.Unlock()
+
+		switch req.URL.Query().Get("page") {
+		case "1":
+			w.Write([]byte(`{"data": [{"id": "team-1", "name": "Infrastructure"}], "pagination": {"page": 1, "pages": 3}}`))
+		case "2":
+			w.Write([]byte(`{"data": [{"id": "team-2", "name": "Infrastructure Oncall"}], "pagination": {"page": 2, "pages": 3}}`))
+		default:
+			w.Write([]byte(`{"data": [{"id": "team-3", "name": "Infrastructure"}], "pagination": {"page": 3, "pages": 3}}`))
+		}
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	c, err := NewRestClient("testing-123", WithBaseURL(ts.URL))
+	require.NoError(t, err)
+
+	res, err := c.ListTeams(context.TODO(), &TeamQuery{Query: "Infrastructure"})
+	require.NoError(t, err, "error listing teams")
+
+	// Pages after the first are fetched at the same time, so they can be requested in any order
+	assert.ElementsMatch(t, []string{"page=1&query=Infrastructure", "page=2&query=Infrastructure", "page=3&query=Infrastructure"}, requests)
+	require.Len(t, res.Teams, 3)
+	assert.Equal(t, "team-3", res.Teams[2].ID, "teams past the first page should be included")
+
+	// Asking for a page only fetches that page
+	requests = nil
+	res, err = c.ListTeams(context.TODO(), &TeamQuery{Query: "Infrastructure", Page: 2, PerPage: 1})
+	require.NoError(t, err, "error listing a page of teams")
+
+	assert.Equal(t, []string{"page=2&per_page=1&query=Infrastructure"}, requests)
+	require.Len(t, res.Teams, 1)
+}
+
+func TestCreateTeamDefaultIncidentRole(t *testing.T) {
+	req := TeamDefaultIncidentRole{IncidentRoleID: "test-role-id", UserID: "test-user-id"}
+	c, teardown, err := setupClient("/teams/test-team-id/default_incident_roles", &struct{}{},
+		AssertRequestJSONBody(t, req),
+		AssertRequestMethod(t, "POST"),
+	)
+
+	require.NoError(t, err)
+	defer teardown()
+
+	err = c.CreateTeamDefaultIncidentRole(context.TODO(), "test-team-id", req)
+	require.NoError(t, err, "error creating a team default incident role")
+}
+
+func TestDeleteTeamDefaultIncidentRole(t *testing.T) {
+	c, teardown, err := setupClient("/teams/test-team-id/default_incident_roles/test-role-id", &struct{}{},
+		AssertRequestMethod(t, "DELETE"),
+	)
+
+	require.NoError(t, err)
+	defer teardown()
+
+	err = c.DeleteTeamDefaultIncidentRole(context.TODO(), "test-team-id", "test-role-id")
+	require.NoError(t, err, "error deleting a team default incident role")
+}
@@ -29,17 +29,109 @@ type PingResponse struct {
 type CreateServiceRequest struct {
 	Name        string            `json:"name"`
 	Description string            `json:"description"`
-	ServiceTier int               `json:"service_tier,int,omitempty"`
+	ServiceTier *int              `json:"service_tier,omitempty"`
 	Labels      map[string]string `json:"labels,omitempty"`
+	Owner       *ServiceTeam      `json:"owner,omitempty"`
+
+	// Teams are the teams that respond to the service, which can differ from its owner
+	Teams []ServiceTeam `json:"teams,omitempty"`
+
+	// Slug is generated from the name by FireHydrant when it is empty
+	Slug string `json:"slug,omitempty"`
+
+	// AlertOnAdd is a pointer so that an explicit false is sent, leaving FireHydrant's default when nil
+	AlertOnAdd *bool `json:"alert_on_add,omitempty"`
+
+	// CustomFields are the values of the organization's custom fields, keyed by the field's slug
+	CustomFields map[string]string `json:"custom_fields,omitempty"`
+
+	ExternalResources []ExternalResource `json:"external_resources,omitempty"`
 }
 
-// UpdateServiceRequest is the payload for updating a service
+// UpdateServiceRequest is the payload for updating a service. Only the fields that are set are sent,
+// so that anything else changed in FireHydrant since the service was read is left alone
 // URL: PATCH https://api.firehydrant.io/v1/services/{id}
 type UpdateServiceRequest struct {
-	Name        string            `json:"name,omitempty"`
-	Description string            `json:"description,omitempty"`
-	ServiceTier int               `json:"service_tier,int,omitempty"`
-	Labels      map[string]string `json:"labels,omitempty"`
+	// Name, Description, ServiceTier, and Labels are left unchanged when they are nil, and are
+	// pointers so that they can be cleared
+	Name        *string            `json:"name,omitempty"`
+	Description *string            `json:"description,omitempty"`
+	ServiceTier *int               `json:"service_tier,omitempty"`
+	Labels      *map[string]string `json:"labels,omitempty"`
+
+	// Owner is left unchanged when it is nil
+	Owner *ServiceTeam `json:"owner,omitempty"`
+
+	// Teams replaces every team that responds to the service. It is left unchanged when it is nil, and
+	// is a pointer so that every team can be removed
+	Teams *[]ServiceTeam `json:"teams,omitempty"`
+
+	// AlertOnAdd is left unchanged when it is nil, and is a pointer so that it can be set to false
+	AlertOnAdd *bool `json:"alert_on_add,omitempty"`
+
+	// CustomFields replaces every custom field value of the service. It is left unchanged when it is
+	// nil, and is a pointer so that every value can be cleared
+	CustomFields *map[string]string `json:"custom_fields,omitempty"`
+
+	// ExternalResources are added to the service, or removed when Remove is set. Any
+	// external resources that are not listed are left as they are
+	ExternalResources []ExternalResource `json:"external_resources,omitempty"`
+}
+
+// ServiceTeam is a team as it is referenced from a service or functionality, such as the team that owns it
+type ServiceTeam struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// ExternalResource links a FireHydrant object to the matching object in another tool, such as a
+// PagerDuty service
+type ExternalResource struct {
+	ConnectionType string `json:"connection_type"`
+
+	// ConnectionID picks which connection of ConnectionType to use when there are several. FireHydrant
+	// uses the only one when it is empty
+	ConnectionID string `json:"connection_id,omitempty"`
+
+	RemoteID  string `json:"remote_id"`
+	RemoteURL string `json:"remote_url,omitempty"`
+
+	// Remove is only used in requests, to unlink the external resource
+	Remove bool `json:"remove,omitempty"`
+}
+
+// ServiceLink is a named link shown on a service, such as its dashboard or runbook
+type ServiceLink struct {
+	ID      string `json:"id,omitempty"`
+	Name    string `json:"name"`
+	HrefURL string `json:"href_url"`
+}
+
+// UpdateServiceLinksRequest is the payload for replacing the links on a service. It is kept
+// separate from UpdateServiceRequest so that updating a service never touches its links
+// URL: PATCH https://api.firehydrant.io/v1/services/{id}
+type UpdateServiceLinksRequest struct {
+	Links []ServiceLink `json:"links"`
+}
+
+// ServiceSlackChannel is the Slack channel incidents involving a service are posted to
+// URL: GET https://api.firehydrant.io/v1/services/{id}/slack_channel
+// URL: PUT https://api.firehydrant.io/v1/services/{id}/slack_channel
+type ServiceSlackChannel struct {
+	ChannelID   string `json:"channel_id,omitempty"`
+	ChannelName string `json:"channel_name"`
+
+	// AutoCreate creates the channel in Slack when it does not exist yet
+	AutoCreate bool `json:"auto_create"`
+}
+
+// ServiceAlertGrouping is how alerts for a service are grouped together, so that alerts with the same
+// values for every key within the window are treated as one
+// URL: GET https://api.firehydrant.io/v1/services/{id}/alert_grouping
+// URL: PUT https://api.firehydrant.io/v1/services/{id}/alert_grouping
+type ServiceAlertGrouping struct {
+	WindowSeconds int      `json:"window_seconds"`
+	Keys          []string `json:"keys"`
 }
 
 // ServiceResponse is the payload for retrieving a service
@@ -53,15 +145,80 @@ type ServiceResponse struct {
 	CreatedAt   time.Time         `json:"created_at"`
 	UpdatedAt   time.Time         `json:"updated_at"`
 	Labels      map[string]string `json:"labels"`
+	Links       []ServiceLink     `json:"links"`
+	Owner       *ServiceTeam      `json:"owner"`
+	Teams       []ServiceTeam     `json:"teams"`
+	AlertOnAdd  bool              `json:"alert_on_add"`
+
+	// CustomFields are the values of the organization's custom fields, keyed by the field's slug
+	CustomFields map[string]string `json:"custom_fields"`
+
+	ExternalResources []ExternalResource `json:"external_resources"`
+
+	// Functionalities are the functionalities that depend on this service
+	Functionalities []ServiceFunctionality `json:"functionalities"`
+
+	// Environments are the environments this service runs in
+	Environments []ServiceEnvironment `json:"environments"`
+
+	// ActiveIncidents are the IDs of the open incidents this service is involved in
+	ActiveIncidents []string `json:"active_incidents"`
+
+	// LastImport is the most recent import of this service from another tool, and is nil for
+	// services that were never imported
+	LastImport *ServiceImport `json:"last_import"`
+
+	// ManagedBy names the integration that keeps this service in sync, such as PagerDuty. It is empty
+	// for services that are managed by hand
+	ManagedBy string `json:"managed_by"`
+
+	// Status is the service's operational status, such as operational, degraded, or maintenance. It is
+	// empty when FireHydrant does not report one
+	Status string `json:"status"`
+}
+
+// ServiceImport is an import of a service from another tool, such as PagerDuty
+type ServiceImport struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ServiceEnvironment is an environment that a service runs in
+type ServiceEnvironment struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// ServiceFunctionality is a functionality that a service is attached to
+type ServiceFunctionality struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
 }
 
 // ServiceQuery is the query used to search for services
 type ServiceQuery struct {
 	Query          string         `url:"query,omitempty"`
-	ServiceTier    int          `url:"int,service_tier,omitempty"`
+	ServiceTier    int            `url:"service_tier,omitempty"`
 	LabelsSelector LabelsSelector `url:"labels,omitempty"`
+	LabelsMatch    LabelsMatch    `url:"labels_match,omitempty"`
+	Page           int            `url:"page,omitempty"`
+	PerPage        int            `url:"per_page,omitempty"`
 }
 
+// LabelsMatch controls whether a service has to have every label in a LabelsSelector or just one of them
+type LabelsMatch string
+
+const (
+	// LabelsMatchAll only matches services that have every selected label. This is what FireHydrant
+	// does when no LabelsMatch is given
+	LabelsMatchAll LabelsMatch = "all"
+	// LabelsMatchAny matches services that have at least one of the selected labels
+	LabelsMatchAny LabelsMatch = "any"
+)
+
+// LabelsSelector selects services by their labels. It is sent as a comma separated list of key=value
+// pairs, with each key and value URL-encoded so that commas and equals signs inside of them are not
+// mistaken for the separators
 type LabelsSelector map[string]string
 
 // EncodeValues implements Encoder
@@ -77,7 +234,7 @@ func (sq LabelsSelector) EncodeValues(key string, v *url.Values) error {
 	sort.Strings(keys)
 
 	for _, k := range keys {
-		labels = append(labels, fmt.Sprintf("%s=%s", k, sq[k]))
+		labels = append(labels, fmt.Sprintf("%s=%s", url.QueryEscape(k), url.QueryEscape(sq[k])))
 	}
 
 	v.Set(key, strings.Join(labels, ","))
@@ -87,9 +244,18 @@ func (sq LabelsSelector) EncodeValues(key string, v *url.Values) error {
 
 var _ query.Encoder = LabelsSelector{}
 
+// Pagination is the pagination envelope FireHydrant returns alongside list responses
+type Pagination struct {
+	Page       int `json:"page"`
+	PerPage    int `json:"items"`
+	TotalPages int `json:"pages"`
+	TotalCount int `json:"count"`
+}
+
 // ServicesResponse is the payload for retrieving a list of services
 type ServicesResponse struct {
-	Services []ServiceResponse `json:"data"`
+	Services   []ServiceResponse `json:"data"`
+	Pagination *Pagination       `json:"pagination,omitempty"`
 }
 
 // EnvironmentResponse is the payload for a single environment
@@ -101,20 +267,42 @@ type EnvironmentResponse struct {
 	Slug        string    `json:"slug"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
+
+	Labels map[string]string `json:"labels"`
+}
+
+// EnvironmentsResponse is the payload for retrieving a list of environments
+// URL: GET https://api.firehydrant.io/v1/environments
+type EnvironmentsResponse struct {
+	Environments []EnvironmentResponse `json:"data"`
+	Pagination   *Pagination           `json:"pagination,omitempty"`
+}
+
+// EnvironmentQuery is the query used to search for environments
+type EnvironmentQuery struct {
+	Query   string `url:"query,omitempty"`
+	Page    int    `url:"page,omitempty"`
+	PerPage int    `url:"per_page,omitempty"`
 }
 
 // CreateEnvironmentRequest is the payload for creating a service
 // URL: POST https://api.firehydrant.io/v1/services
 type CreateEnvironmentRequest struct {
-	Name        string `json:"name"`
-	Description string `json:"description"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Labels      map[string]string `json:"labels,omitempty"`
 }
 
 // UpdateEnvironmentRequest is the payload for updating a environment
 // URL: PATCH https://api.firehydrant.io/v1/environments/{id}
 type UpdateEnvironmentRequest struct {
-	Name        string `json:"name,omitempty"`
-	Description string `json:"description,omitempty"`
+	Name string `json:"name,omitempty"`
+
+	// Description is left unchanged when it is nil, and is a pointer so that it can be cleared
+	Description *string `json:"description,omitempty"`
+
+	// Labels are left unchanged when they are nil, and are a pointer so that they can be cleared
+	Labels *map[string]string `json:"labels,omitempty"`
 }
 
 // FunctionalityResponse is the payload for a single environment
@@ -125,8 +313,25 @@ type FunctionalityResponse struct {
 	Description string            `json:"description"`
 	Slug        string            `json:"slug"`
 	Services    []ServiceResponse `json:"services"`
+	Owner       *ServiceTeam      `json:"owner"`
 	CreatedAt   time.Time         `json:"created_at"`
 	UpdatedAt   time.Time         `json:"updated_at"`
+
+	ExternalResources []ExternalResource `json:"external_resources"`
+}
+
+// FunctionalitiesResponse is the payload for retrieving a list of functionalities
+// URL: GET https://api.firehydrant.io/v1/functionalities
+type FunctionalitiesResponse struct {
+	Functionalities []FunctionalityResponse `json:"data"`
+	Pagination      *Pagination             `json:"pagination,omitempty"`
+}
+
+// FunctionalityQuery is the query used to search for functionalities
+type FunctionalityQuery struct {
+	Query   string `url:"query,omitempty"`
+	Page    int    `url:"page,omitempty"`
+	PerPage int    `url:"per_page,omitempty"`
 }
 
 // CreateFunctionalityRequest is the payload for creating a service
@@ -135,6 +340,9 @@ type CreateFunctionalityRequest struct {
 	Name        string                 `json:"name"`
 	Description string                 `json:"description"`
 	Services    []FunctionalityService `json:"services,omitempty"`
+	Owner       *ServiceTeam           `json:"owner,omitempty"`
+
+	ExternalResources []ExternalResource `json:"external_resources,omitempty"`
 }
 
 // FunctionalityService represents a service when creating a functionality
@@ -145,9 +353,18 @@ type FunctionalityService struct {
 // UpdateFunctionalityRequest is the payload for updating a environment
 // URL: PATCH https://api.firehydrant.io/v1/environments/{id}
 type UpdateFunctionalityRequest struct {
-	Name        string                 `json:"name,omitempty"`
-	Description string                 `json:"description,omitempty"`
-	Services    []FunctionalityService `json:"services,omitempty"`
+	Name     string                 `json:"name,omitempty"`
+	Services []FunctionalityService `json:"services,omitempty"`
+
+	// Description is left unchanged when it is nil, and is a pointer so that it can be cleared
+	Description *string `json:"description,omitempty"`
+
+	// Owner is left unchanged when it is nil
+	Owner *ServiceTeam `json:"owner,omitempty"`
+
+	// ExternalResources are added to the functionality, or removed when Remove is set. Any
+	// external resources that are not listed are left as they are
+	ExternalResources []ExternalResource `json:"external_resources,omitempty"`
 }
 
 // TeamResponse is the payload for a single environment
@@ -158,8 +375,45 @@ type TeamResponse struct {
 	Description string            `json:"description"`
 	Slug        string            `json:"slug"`
 	Services    []ServiceResponse `json:"services"`
-	CreatedAt   time.Time         `json:"created_at"`
-	UpdatedAt   time.Time         `json:"updated_at"`
+	Memberships []TeamMembership  `json:"memberships"`
+
+	DefaultIncidentRoles []TeamDefaultIncidentRole `json:"default_incident_roles"`
+
+	// DefaultEscalationPolicyID is the escalation policy pages for the team go to, which is empty when
+	// the team has none
+	DefaultEscalationPolicyID string `json:"default_escalation_policy_id"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TeamMembership is a user on a team and the role they have on it
+// URL: POST https://api.firehydrant.io/v1/teams/{id}/memberships
+type TeamMembership struct {
+	UserID string `json:"user_id"`
+	Role   string `json:"role,omitempty"`
+}
+
+// TeamDefaultIncidentRole is an incident role filled in when the team declares an incident, and
+// optionally the user it is assigned to
+// URL: POST https://api.firehydrant.io/v1/teams/{id}/default_incident_roles
+type TeamDefaultIncidentRole struct {
+	IncidentRoleID string `json:"incident_role_id"`
+	UserID         string `json:"user_id,omitempty"`
+}
+
+// TeamsResponse is the payload for retrieving a list of teams
+// URL: GET https://api.firehydrant.io/v1/teams
+type TeamsResponse struct {
+	Teams      []TeamResponse `json:"data"`
+	Pagination *Pagination    `json:"pagination,omitempty"`
+}
+
+// TeamQuery is the query used to search for teams
+type TeamQuery struct {
+	Query   string `url:"query,omitempty"`
+	Page    int    `url:"page,omitempty"`
+	PerPage int    `url:"per_page,omitempty"`
 }
 
 // CreateTeamRequest is the payload for creating a service
@@ -167,6 +421,7 @@ type TeamResponse struct {
 type CreateTeamRequest struct {
 	Name        string   `json:"name"`
 	Description string   `json:"description"`
+	Slug        string   `json:"slug,omitempty"`
 	ServiceIDs  []string `json:"service_ids,omitempty"`
 }
 
@@ -181,6 +436,9 @@ type UpdateTeamRequest struct {
 	Name        string   `json:"name,omitempty"`
 	Description string   `json:"description,omitempty"`
 	ServiceIDs  []string `json:"service_ids,omitempty"`
+
+	// DefaultEscalationPolicyID is left alone when nil, and an empty string removes the team's policy
+	DefaultEscalationPolicyID *string `json:"default_escalation_policy_id,omitempty"`
 }
 
 // SeverityResponse is the payload for a single environment
@@ -188,13 +446,36 @@ type UpdateTeamRequest struct {
 type SeverityResponse struct {
 	Slug        string `json:"slug"`
 	Description string `json:"description"`
+
+	// Type is the kind of event the severity is for, such as unexpected_downtime or maintenance
+	Type  string `json:"type"`
+	Color string `json:"color"`
+
+	// DefaultPriority is the slug of the priority incidents with this severity start with, if any
+	DefaultPriority string `json:"default_priority"`
+}
+
+// SeveritiesResponse is the payload for retrieving a list of severities
+// URL: GET https://api.firehydrant.io/v1/severities
+type SeveritiesResponse struct {
+	Severities []SeverityResponse `json:"data"`
+	Pagination *Pagination        `json:"pagination,omitempty"`
+}
+
+// SeverityQuery is the query used to list severities
+type SeverityQuery struct {
+	Page    int `url:"page,omitempty"`
+	PerPage int `url:"per_page,omitempty"`
 }
 
 // CreateSeverityRequest is the payload for creating a service
 // URL: POST https://api.firehydrant.io/v1/severities
 type CreateSeverityRequest struct {
-	Slug        string `json:"slug"`
-	Description string `json:"description"`
+	Slug            string `json:"slug"`
+	Description     string `json:"description"`
+	Type            string `json:"type,omitempty"`
+	Color           string `json:"color,omitempty"`
+	DefaultPriority string `json:"default_priority,omitempty"`
 }
 
 // UpdateSeverityRequest is the payload for updating a environment
@@ -202,4 +483,29 @@ type CreateSeverityRequest struct {
 type UpdateSeverityRequest struct {
 	Slug        string `json:"slug,omitempty"`
 	Description string `json:"description,omitempty"`
+	Type        string `json:"type,omitempty"`
+	Color       string `json:"color,omitempty"`
+
+	// DefaultPriority is left unchanged when it is nil, and an empty string removes it
+	DefaultPriority *string `json:"default_priority,omitempty"`
+}
+
+// Bool returns a pointer to v, for optional request fields where false has to be sent
+func Bool(v bool) *bool {
+	return &v
+}
+
+// String returns a pointer to v, for optional request fields where "" has to be sent
+func String(v string) *string {
+	return &v
+}
+
+// StringMap returns a pointer to v, for optional request fields where an empty map has to be sent
+func StringMap(v map[string]string) *map[string]string {
+	return &v
+}
+
+// Int returns a pointer to v, for optional request fields where 0 has to be sent
+func Int(v int) *int {
+	return &v
 }
@@ -0,0 +1,75 @@
+package firehydrant
+
+import (
+	"context"
+	"time"
+
+	"github.com/dghubble/sling"
+	"github.com/pkg/errors"
+)
+
+// UserResponse is the payload for retrieving a user
+// URL: GET https://api.firehydrant.io/v1/users/{id}
+type UserResponse struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// UsersResponse is the payload for retrieving a list of users
+// URL: GET https://api.firehydrant.io/v1/users
+type UsersResponse struct {
+	Users      []UserResponse `json:"data"`
+	Pagination *Pagination    `json:"pagination,omitempty"`
+}
+
+// UserQuery is the query used to search for users, matching their name or email
+type UserQuery struct {
+	Query string `url:"query,omitempty"`
+}
+
+// UsersClient is an interface for interacting with users on FireHydrant
+type UsersClient interface {
+	Get(ctx context.Context, id string) (*UserResponse, error)
+	List(ctx context.Context, req *UserQuery) (*UsersResponse, error)
+}
+
+// RESTUsersClient implements the UsersClient interface
+type RESTUsersClient struct {
+	client *APIClient
+}
+
+var _ UsersClient = &RESTUsersClient{}
+
+func (c *RESTUsersClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
+}
+
+// Get returns a user from the FireHydrant API
+func (c *RESTUsersClient) Get(ctx context.Context, id string) (*UserResponse, error) {
+	res := &UserResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get("users/"+id).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get user")
+	}
+
+	return res, nil
+}
+
+// List retrieves the users matching a query
+func (c *RESTUsersClient) List(ctx context.Context, req *UserQuery) (*UsersResponse, error) {
+	res := &UsersResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get("users").QueryStruct(req).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get users")
+	}
+
+	return res, nil
+}
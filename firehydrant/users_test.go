@@ -0,0 +1,56 @@
+package firehydrant
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestListUsers(t *testing.T) {
+	var requestURIRcvd string
+
+	expectedUsers := UsersResponse{
+		Users: []UserResponse{
+			{
+				ID:    "test-id",
+				Name:  "Bobby Tables",
+				Email: "bobby+dalmatians@firehydrant.io",
+			},
+		},
+	}
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requestURIRcvd = req.URL.RequestURI()
+
+		if err := json.NewEncoder(w).Encode(expectedUsers); err != nil {
+			panic(err)
+		}
+	})
+	ts := httptest.NewServer(h)
+
+	defer ts.Close()
+
+	testToken := "testing-123"
+	c, err := NewRestClient(testToken, WithBaseURL(ts.URL))
+
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+		return
+	}
+
+	res, err := c.Users().List(context.TODO(), &UserQuery{Query: "bobby+dalmatians@firehydrant.io"})
+	if err != nil {
+		t.Fatalf("Received error hitting user list endpoint: %s", err.Error())
+	}
+
+	if expected := "/users?query=bobby%2Bdalmatians%40firehydrant.io"; expected != requestURIRcvd {
+		t.Fatalf("Expected %s, Got: %s for request path", expected, requestURIRcvd)
+	}
+
+	if !reflect.DeepEqual(&expectedUsers, res) {
+		t.Fatalf("Expected %+v, Got: %+v for response", expectedUsers, res)
+	}
+}
@@ -0,0 +1,28 @@
+package firehydrant
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// WebhookSignatureHeader is the header FireHydrant sends the signature of a webhook's body in
+const WebhookSignatureHeader = "fh-signature"
+
+// SignWebhook returns the signature FireHydrant sends for a webhook body delivered to a webhook with
+// the given secret, which is the hex encoded HMAC-SHA256 of the body
+func SignWebhook(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhookSignature reports whether signature, taken from the WebhookSignatureHeader of a webhook
+// FireHydrant delivered, matches the raw body for the webhook's secret. The body has to be exactly as
+// it was received, since decoding and encoding it again can change it. The comparison takes the same
+// time however much of the signature matches, so it does not leak the expected signature
+func VerifyWebhookSignature(secret string, body []byte, signature string) bool {
+	expected := []byte(SignWebhook(secret, body))
+	return hmac.Equal(expected, []byte(strings.ToLower(strings.TrimSpace(signature))))
+}
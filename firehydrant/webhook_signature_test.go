@@ -0,0 +1,22 @@
+package firehydrant
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	body := []byte(`{"event":{"operation":"CREATED","resource_type":"incident"}}`)
+	// echo -n "$body" | openssl dgst -sha256 -hmac "shh"
+	signature := "5ef475e5f0ecdf1d3f7bf0c09b43e8f68b27fb99be317efa63e70b9a2cd83ff8"
+
+	assert.Equal(t, signature, SignWebhook("shh", body))
+	assert.True(t, VerifyWebhookSignature("shh", body, signature))
+	assert.True(t, VerifyWebhookSignature("shh", body, " "+signature+"\n"), "surrounding whitespace should be ignored")
+
+	assert.False(t, VerifyWebhookSignature("wrong-secret", body, signature))
+	assert.False(t, VerifyWebhookSignature("shh", append(body, ' '), signature), "a changed body should not verify")
+	assert.False(t, VerifyWebhookSignature("shh", body, ""))
+	assert.False(t, VerifyWebhookSignature("shh", body, signature[:32]))
+}
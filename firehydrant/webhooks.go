@@ -0,0 +1,108 @@
+package firehydrant
+
+import (
+	"context"
+	"time"
+
+	"github.com/dghubble/sling"
+	"github.com/pkg/errors"
+)
+
+// WebhookResponse is the payload for retrieving a webhook
+// URL: GET https://api.firehydrant.io/v1/webhooks/{id}
+type WebhookResponse struct {
+	ID            string    `json:"id"`
+	URL           string    `json:"url"`
+	State         string    `json:"state"`
+	Subscriptions []string  `json:"subscriptions"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// CreateWebhookRequest is the payload for creating a webhook
+// URL: POST https://api.firehydrant.io/v1/webhooks
+type CreateWebhookRequest struct {
+	URL           string   `json:"url"`
+	Secret        string   `json:"secret,omitempty"`
+	State         string   `json:"state"`
+	Subscriptions []string `json:"subscriptions"`
+}
+
+// UpdateWebhookRequest is the payload for updating a webhook
+// URL: PATCH https://api.firehydrant.io/v1/webhooks/{id}
+type UpdateWebhookRequest struct {
+	URL           string   `json:"url,omitempty"`
+	Secret        string   `json:"secret,omitempty"`
+	State         string   `json:"state,omitempty"`
+	Subscriptions []string `json:"subscriptions"`
+}
+
+// WebhooksClient is an interface for interacting with webhooks on FireHydrant
+type WebhooksClient interface {
+	Get(ctx context.Context, id string) (*WebhookResponse, error)
+	Create(ctx context.Context, createReq CreateWebhookRequest) (*WebhookResponse, error)
+	Update(ctx context.Context, id string, updateReq UpdateWebhookRequest) (*WebhookResponse, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// RESTWebhooksClient implements the WebhooksClient interface
+type RESTWebhooksClient struct {
+	client *APIClient
+}
+
+var _ WebhooksClient = &RESTWebhooksClient{}
+
+func (c *RESTWebhooksClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
+}
+
+// Get returns a webhook from the FireHydrant API
+func (c *RESTWebhooksClient) Get(ctx context.Context, id string) (*WebhookResponse, error) {
+	res := &WebhookResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get("webhooks/"+id).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get webhook")
+	}
+
+	return res, nil
+}
+
+// Create creates a webhook in FireHydrant
+func (c *RESTWebhooksClient) Create(ctx context.Context, createReq CreateWebhookRequest) (*WebhookResponse, error) {
+	res := &WebhookResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Post("webhooks").BodyJSON(&createReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not create webhook")
+	}
+
+	return res, nil
+}
+
+// Update updates a webhook in FireHydrant
+func (c *RESTWebhooksClient) Update(ctx context.Context, id string, updateReq UpdateWebhookRequest) (*WebhookResponse, error) {
+	res := &WebhookResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Patch("webhooks/"+id).BodyJSON(&updateReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not update webhook")
+	}
+
+	return res, nil
+}
+
+// Delete deletes a webhook from FireHydrant
+func (c *RESTWebhooksClient) Delete(ctx context.Context, id string) error {
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Delete("webhooks/"+id).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not delete webhook")
+	}
+
+	return nil
+}
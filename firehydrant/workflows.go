@@ -0,0 +1,145 @@
+package firehydrant
+
+import (
+	"context"
+	"time"
+
+	"github.com/dghubble/sling"
+	"github.com/pkg/errors"
+)
+
+// WorkflowCondition narrows down which events start a workflow, such as only incidents of one severity
+type WorkflowCondition struct {
+	Field    string `json:"field"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
+// WorkflowTrigger is the event that starts a workflow, and the conditions the event has to meet
+type WorkflowTrigger struct {
+	Event      string              `json:"event"`
+	Conditions []WorkflowCondition `json:"conditions"`
+}
+
+// WorkflowStep is a step of a workflow, which runs a runbook action. Steps run in the order they are listed
+type WorkflowStep struct {
+	ID       string            `json:"id,omitempty"`
+	Name     string            `json:"name"`
+	ActionID string            `json:"action_id"`
+	Config   map[string]string `json:"config,omitempty"`
+}
+
+// WorkflowRoleAssignment assigns an incident role to a user, or to whoever is on call for a schedule,
+// on the incidents a workflow runs for
+type WorkflowRoleAssignment struct {
+	IncidentRoleID string `json:"incident_role_id"`
+	UserID         string `json:"user_id,omitempty"`
+	ScheduleID     string `json:"schedule_id,omitempty"`
+}
+
+// WorkflowResponse is the payload for retrieving a workflow
+// URL: GET https://api.firehydrant.io/v1/workflows/{id}
+type WorkflowResponse struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Trigger     WorkflowTrigger `json:"trigger"`
+	Steps       []WorkflowStep  `json:"steps"`
+
+	RoleAssignments []WorkflowRoleAssignment `json:"role_assignments"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CreateWorkflowRequest is the payload for creating a workflow
+// URL: POST https://api.firehydrant.io/v1/workflows
+type CreateWorkflowRequest struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Trigger     WorkflowTrigger `json:"trigger"`
+	Steps       []WorkflowStep  `json:"steps"`
+
+	RoleAssignments []WorkflowRoleAssignment `json:"role_assignments,omitempty"`
+}
+
+// UpdateWorkflowRequest is the payload for updating a workflow. Only the name, description, and role
+// assignments can be updated, so changing a workflow's trigger or steps means replacing it. Role
+// assignments replace the workflow's existing ones when set, so an empty list removes them all
+// URL: PATCH https://api.firehydrant.io/v1/workflows/{id}
+type UpdateWorkflowRequest struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description"`
+
+	RoleAssignments *[]WorkflowRoleAssignment `json:"role_assignments,omitempty"`
+}
+
+// WorkflowsClient is an interface for interacting with workflows on FireHydrant
+type WorkflowsClient interface {
+	Get(ctx context.Context, id string) (*WorkflowResponse, error)
+	Create(ctx context.Context, createReq CreateWorkflowRequest) (*WorkflowResponse, error)
+	Update(ctx context.Context, id string, updateReq UpdateWorkflowRequest) (*WorkflowResponse, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// RESTWorkflowsClient implements the WorkflowsClient interface
+type RESTWorkflowsClient struct {
+	client *APIClient
+}
+
+var _ WorkflowsClient = &RESTWorkflowsClient{}
+
+func (c *RESTWorkflowsClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
+}
+
+// Get returns a workflow from the FireHydrant API
+func (c *RESTWorkflowsClient) Get(ctx context.Context, id string) (*WorkflowResponse, error) {
+	res := &WorkflowResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Get("workflows/"+id).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not get workflow")
+	}
+
+	return res, nil
+}
+
+// Create creates a workflow in FireHydrant
+func (c *RESTWorkflowsClient) Create(ctx context.Context, createReq CreateWorkflowRequest) (*WorkflowResponse, error) {
+	res := &WorkflowResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Post("workflows").BodyJSON(&createReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not create workflow")
+	}
+
+	return res, nil
+}
+
+// Update updates a workflow in FireHydrant
+func (c *RESTWorkflowsClient) Update(ctx context.Context, id string, updateReq UpdateWorkflowRequest) (*WorkflowResponse, error) {
+	res := &WorkflowResponse{}
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Patch("workflows/"+id).BodyJSON(&updateReq).Receive(res, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return nil, errors.Wrap(err, "could not update workflow")
+	}
+
+	return res, nil
+}
+
+// Delete deletes a workflow from FireHydrant
+func (c *RESTWorkflowsClient) Delete(ctx context.Context, id string) error {
+	apiErr := &APIError{}
+
+	resp, err := c.restClient(ctx).Delete("workflows/"+id).Receive(nil, apiErr)
+	if err := checkResponse(resp, err, apiErr); err != nil {
+		return errors.Wrap(err, "could not delete workflow")
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package firehydrant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateWorkflow(t *testing.T) {
+	resp := &WorkflowResponse{}
+	req := CreateWorkflowRequest{
+		Name: "Open a channel for SEV1s",
+		Trigger: WorkflowTrigger{
+			Event:      "incident.opened",
+			Conditions: []WorkflowCondition{{Field: "severity", Operator: "equals", Value: "SEV1"}},
+		},
+		Steps: []WorkflowStep{
+			{Name: "Create Incident Channel", ActionID: "test-action-id", Config: map[string]string{"channel_name_format": "-inc-{{ number }}"}},
+		},
+	}
+	c, teardown, err := setupClient("/workflows", resp,
+		AssertRequestJSONBody(t, req),
+		AssertRequestMethod(t, "POST"),
+	)
+	require.NoError(t, err)
+	defer teardown()
+
+	_, err = c.Workflows().Create(context.TODO(), req)
+	require.NoError(t, err, "error creating a workflow")
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceChangeEvent() *schema.Resource {
+	return &schema.Resource{
+		Description:   "FireHydrant change events record changes, such as deploys, so that they can be correlated with incidents.",
+		CreateContext: createResourceFireHydrantChangeEvent,
+		UpdateContext: updateResourceFireHydrantChangeEvent,
+		ReadContext:   readResourceFireHydrantChangeEvent,
+		DeleteContext: deleteResourceFireHydrantChangeEvent,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"summary": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"service_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the services the change affected.",
+			},
+			"environment_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the environments the change affected.",
+			},
+		},
+	}
+}
+
+func readResourceFireHydrantChangeEvent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	r, err := ac.ChangeEvents().Get(ctx, d.Id())
+	if firehydrant.IsNotFound(err) {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := convertChangeEventToState(r, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func createResourceFireHydrantChangeEvent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r := firehydrant.CreateChangeEventRequest{
+		Summary:      d.Get("summary").(string),
+		Description:  d.Get("description").(string),
+		Services:     convertStringSet(d.Get("service_ids").(*schema.Set)),
+		Environments: convertStringSet(d.Get("environment_ids").(*schema.Set)),
+	}
+
+	resource, err := ac.ChangeEvents().Create(ctx, r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.ID)
+
+	if err := convertChangeEventToState(resource, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func updateResourceFireHydrantChangeEvent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r := firehydrant.UpdateChangeEventRequest{
+		Summary:      d.Get("summary").(string),
+		Description:  d.Get("description").(string),
+		Services:     convertStringSet(d.Get("service_ids").(*schema.Set)),
+		Environments: convertStringSet(d.Get("environment_ids").(*schema.Set)),
+	}
+
+	_, err := ac.ChangeEvents().Update(ctx, d.Id(), r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+// deleteResourceFireHydrantChangeEvent only removes the change event from state. Change events are a
+// record of what happened, so they are kept in FireHydrant for correlating with past incidents
+func deleteResourceFireHydrantChangeEvent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId("")
+	return diag.Diagnostics{}
+}
+
+func convertChangeEventToState(changeEvent *firehydrant.ChangeEventResponse, d *schema.ResourceData) error {
+	serviceIDs := make([]string, len(changeEvent.Services))
+	for index, service := range changeEvent.Services {
+		serviceIDs[index] = service.ID
+	}
+
+	environmentIDs := make([]string, len(changeEvent.Environments))
+	for index, environment := range changeEvent.Environments {
+		environmentIDs[index] = environment.ID
+	}
+
+	attributes := map[string]interface{}{
+		"summary":         changeEvent.Summary,
+		"description":     changeEvent.Description,
+		"service_ids":     serviceIDs,
+		"environment_ids": environmentIDs,
+	}
+
+	return setAttributesFromMap(d, attributes)
+}
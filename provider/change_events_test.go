@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccChangeEvents(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testFireHydrantIsSetup(t) },
+		ProviderFactories: defaultProviderFactories(),
+		// Destroying a change event keeps it in FireHydrant
+		CheckDestroy: testChangeEventExists("firehydrant_change_event.terraform-acceptance-test-change-event"),
+		Steps: []resource.TestStep{
+			{
+				Config: testChangeEventConfig(rName, "Deploy api"),
+				Check: resource.ComposeTestCheckFunc(
+					testChangeEventExists("firehydrant_change_event.terraform-acceptance-test-change-event"),
+					resource.TestCheckResourceAttr("firehydrant_change_event.terraform-acceptance-test-change-event", "summary", "Deploy api"),
+					resource.TestCheckResourceAttr("firehydrant_change_event.terraform-acceptance-test-change-event", "service_ids.#", "1"),
+					resource.TestCheckResourceAttr("firehydrant_change_event.terraform-acceptance-test-change-event", "environment_ids.#", "1"),
+				),
+			},
+			{
+				Config: testChangeEventConfig(rName, "Roll back api"),
+				Check: resource.ComposeTestCheckFunc(
+					testChangeEventExists("firehydrant_change_event.terraform-acceptance-test-change-event"),
+					resource.TestCheckResourceAttr("firehydrant_change_event.terraform-acceptance-test-change-event", "summary", "Roll back api"),
+				),
+			},
+		},
+	})
+}
+
+const testChangeEventConfigTemplate = `
+resource "firehydrant_service" "terraform-acceptance-test-service" {
+	name = "%s"
+}
+
+resource "firehydrant_environment" "terraform-acceptance-test-environment" {
+	name = "%s"
+}
+
+resource "firehydrant_change_event" "terraform-acceptance-test-change-event" {
+	summary         = "%s"
+	description     = "A change event created by the acceptance tests"
+	service_ids     = [firehydrant_service.terraform-acceptance-test-service.id]
+	environment_ids = [firehydrant_environment.terraform-acceptance-test-environment.id]
+}
+`
+
+func testChangeEventConfig(rName, summary string) string {
+	return fmt.Sprintf(testChangeEventConfigTemplate, rName, rName, summary)
+}
+
+func testChangeEventExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		changeEvent, err := c.ChangeEvents().Get(context.TODO(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if expected, got := rs.Primary.Attributes["summary"], changeEvent.Summary; expected != got {
+			return fmt.Errorf("Unexpected summary. Expected: %s, got: %s", expected, got)
+		}
+
+		return nil
+	}
+}
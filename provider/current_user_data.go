@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCurrentUser() *schema.Resource {
+	return &schema.Resource{
+		Description: "The user or bot the provider's API key belongs to. Reading it checks that the API key works.",
+		ReadContext: dataFireHydrantCurrentUser,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "What kind of actor the API key belongs to, such as a user or a bot.",
+			},
+		},
+	}
+}
+
+func dataFireHydrantCurrentUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r, err := ac.Ping(ctx)
+	if err != nil {
+		return diag.FromErr(apiKeyError(err))
+	}
+
+	attributes := map[string]interface{}{
+		"name":  r.Actor.Name,
+		"email": r.Actor.Email,
+		"type":  r.Actor.Type,
+	}
+	if err := setAttributesFromMap(d, attributes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(r.Actor.ID)
+
+	return diag.Diagnostics{}
+}
+
+// apiKeyError points out when FireHydrant refused the API key, which is otherwise easy to mistake for
+// a problem with whatever was being read
+func apiKeyError(err error) error {
+	var apiErr *firehydrant.APIError
+	if errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden) {
+		return fmt.Errorf("FireHydrant did not accept the API key, check %s or the FIREHYDRANT_API_KEY environment variable: %w", apiKeyName, err)
+	}
+
+	return err
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestCurrentUserDataSource(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/ping" {
+			t.Errorf("Expected request to /ping, Got: %s", req.URL.Path)
+		}
+		w.Write([]byte(`{"actor": {"id": "user-123", "name": "Jane Doe", "email": "jane@example.com", "type": "firehydrant_user"}}`))
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceCurrentUser().Schema, map[string]interface{}{})
+
+	if diags := dataFireHydrantCurrentUser(context.TODO(), d, ac); diags.HasError() {
+		t.Fatalf("Received error reading current user: %+v", diags)
+	}
+
+	if d.Id() != "user-123" {
+		t.Fatalf("Expected ID user-123, Got: %s", d.Id())
+	}
+
+	expected := map[string]string{
+		"name":  "Jane Doe",
+		"email": "jane@example.com",
+		"type":  "firehydrant_user",
+	}
+	for key, value := range expected {
+		if got := d.Get(key); got != value {
+			t.Fatalf("Expected %s, Got: %s for %s", value, got, key)
+		}
+	}
+}
+
+func TestCurrentUserDataSourceRejectedAPIKey(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error": "unauthorized"}`))
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceCurrentUser().Schema, map[string]interface{}{})
+
+	diags := dataFireHydrantCurrentUser(context.TODO(), d, ac)
+	if !diags.HasError() {
+		t.Fatalf("Expected an error reading current user with a rejected API key")
+	}
+	if summary := diags[0].Summary; !strings.Contains(summary, "FIREHYDRANT_API_KEY") {
+		t.Fatalf("Expected error to mention FIREHYDRANT_API_KEY, Got: %s", summary)
+	}
+}
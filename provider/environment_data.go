@@ -2,6 +2,8 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
 
@@ -14,43 +16,92 @@ func dataSourceEnvironment() *schema.Resource {
 		ReadContext: dataFireHydrantEnvironment,
 		Schema: map[string]*schema.Schema{
 			"environment_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"environment_id", "name"},
 			},
 			"name": {
 				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
 			},
 			"description": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"slug": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
 
 func dataFireHydrantEnvironment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ac := m.(firehydrant.Client)
-	id := d.Get("environment_id").(string)
 
-	r, err := ac.GetEnvironment(ctx, id)
-	if err != nil {
+	var r *firehydrant.EnvironmentResponse
+	if id := d.Get("environment_id").(string); id != "" {
+		environment, err := ac.GetEnvironment(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		r = environment
+	} else {
+		environment, err := findEnvironmentByName(ctx, ac, d.Get("name").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		r = environment
+	}
+
+	attributes := map[string]interface{}{
+		"environment_id": r.ID,
+		"name":           r.Name,
+		"description":    r.Description,
+		"slug":           r.Slug,
+		"labels":         r.Labels,
+	}
+	if err := setAttributesFromMap(d, attributes); err != nil {
 		return diag.FromErr(err)
 	}
 
-	var ds diag.Diagnostics
-	env := map[string]string{
-		"name":        r.Name,
-		"description": r.Description,
+	d.SetId(r.ID)
+
+	return diag.Diagnostics{}
+}
+
+// findEnvironmentByName resolves an environment name using the environment search, which matches more than the exact name
+func findEnvironmentByName(ctx context.Context, ac firehydrant.Client, name string) (*firehydrant.EnvironmentResponse, error) {
+	environments, err := ac.ListEnvironments(ctx, &firehydrant.EnvironmentQuery{Query: name})
+	if err != nil {
+		return nil, err
 	}
 
-	for key, val := range env {
-		if err := d.Set(key, val); err != nil {
-			return diag.FromErr(err)
+	var matches []firehydrant.EnvironmentResponse
+	for _, environment := range environments.Environments {
+		if environment.Name == name {
+			matches = append(matches, environment)
 		}
 	}
 
-	d.SetId(r.ID)
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("could not find an environment named %q", name)
+	case 1:
+		return &matches[0], nil
+	}
+
+	slugs := make([]string, len(matches))
+	for index, environment := range matches {
+		slugs[index] = environment.Slug
+	}
 
-	return ds
+	return nil, fmt.Errorf("found %d environments named %q (slugs: %s), set environment_id instead of name to pick one", len(matches), name, strings.Join(slugs, ", "))
 }
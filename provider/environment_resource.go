@@ -7,6 +7,7 @@ import (
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceEnvironment() *schema.Resource {
@@ -28,6 +29,19 @@ func resourceEnvironment() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Key/value pairs for describing and filtering environments, such as tier or region.",
+			},
+			"delete_behavior": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      deleteBehaviorArchive,
+				ValidateFunc: validation.StringInSlice([]string{deleteBehaviorArchive, deleteBehaviorDestroy}, false),
+				Description:  "What happens to the environment when it is removed from Terraform. archive keeps the environment and its incident history in FireHydrant, while destroy permanently deletes both.",
+			},
 		},
 	}
 }
@@ -35,18 +49,19 @@ func resourceEnvironment() *schema.Resource {
 func readResourceFireHydrantEnvironment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ac := m.(firehydrant.Client)
 	r, err := ac.GetEnvironment(ctx, d.Id())
-	if err != nil {
-		return diag.FromErr(err)
+	if firehydrant.IsNotFound(err) {
+		d.SetId("")
+		return diag.Diagnostics{}
 	}
-
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	var ds diag.Diagnostics
-	svc := map[string]string{
+	svc := map[string]interface{}{
 		"name":        r.Name,
 		"description": r.Description,
+		"labels":      r.Labels,
 	}
 
 	for key, val := range svc {
@@ -55,6 +70,14 @@ func readResourceFireHydrantEnvironment(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
+	// Like a service's, delete_behavior only lives in Terraform, so environments that were imported or
+	// in state before it existed get the default here
+	if d.Get("delete_behavior").(string) == "" {
+		if err := d.Set("delete_behavior", deleteBehaviorArchive); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return ds
 }
 
@@ -65,6 +88,7 @@ func createResourceFireHydrantEnvironment(ctx context.Context, d *schema.Resourc
 	r := firehydrant.CreateEnvironmentRequest{
 		Name:        name,
 		Description: description,
+		Labels:      convertStringMap(d.Get("labels").(map[string]interface{})),
 	}
 
 	resource, err := ac.CreateEnvironment(ctx, r)
@@ -77,6 +101,7 @@ func createResourceFireHydrantEnvironment(ctx context.Context, d *schema.Resourc
 	attributes := map[string]interface{}{
 		"name":        resource.Name,
 		"description": resource.Description,
+		"labels":      resource.Labels,
 	}
 	if err := setAttributesFromMap(d, attributes); err != nil {
 		return diag.FromErr(fmt.Errorf("could not set attributes: %w", err))
@@ -87,13 +112,23 @@ func createResourceFireHydrantEnvironment(ctx context.Context, d *schema.Resourc
 
 func updateResourceFireHydrantEnvironment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ac := m.(firehydrant.Client)
+
+	// delete_behavior only lives in Terraform, so changing just that has nothing to send
+	if !d.HasChanges("name", "description", "labels") {
+		return diag.Diagnostics{}
+	}
 	id := d.Id()
 	name := d.Get("name").(string)
-	description := d.Get("description").(string)
 
 	r := firehydrant.UpdateEnvironmentRequest{
-		Name:        name,
-		Description: description,
+		Name: name,
+	}
+	if d.HasChange("description") {
+		r.Description = firehydrant.String(d.Get("description").(string))
+	}
+	if d.HasChange("labels") {
+		labels := convertStringMap(d.Get("labels").(map[string]interface{}))
+		r.Labels = &labels
 	}
 
 	_, err := ac.UpdateEnvironment(ctx, id, r)
@@ -108,7 +143,12 @@ func deleteResourceFireHydrantEnvironment(ctx context.Context, d *schema.Resourc
 	ac := m.(firehydrant.Client)
 	EnvironmentID := d.Id()
 
-	err := ac.DeleteEnvironment(ctx, EnvironmentID)
+	var err error
+	if d.Get("delete_behavior").(string) == deleteBehaviorDestroy {
+		err = ac.DestroyEnvironment(ctx, EnvironmentID)
+	} else {
+		err = ac.DeleteEnvironment(ctx, EnvironmentID)
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceEscalationPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description:   "FireHydrant escalation policies define who on a team gets notified, and in what order, when an alert goes unacknowledged.",
+		CreateContext: createResourceFireHydrantEscalationPolicy,
+		UpdateContext: updateResourceFireHydrantEscalationPolicy,
+		ReadContext:   readResourceFireHydrantEscalationPolicy,
+		DeleteContext: deleteResourceFireHydrantEscalationPolicy,
+		Importer: &schema.ResourceImporter{
+			StateContext: importScopedResource("team_id"),
+		},
+		Schema: map[string]*schema.Schema{
+			"team_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"repetitions": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"steps": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timeout": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"targets": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{"schedule", "user", "team"}, false),
+									},
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readResourceFireHydrantEscalationPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	r, err := ac.EscalationPolicies().Get(ctx, d.Get("team_id").(string), d.Id())
+	if firehydrant.IsNotFound(err) {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := convertEscalationPolicyToState(r, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func createResourceFireHydrantEscalationPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r := firehydrant.CreateEscalationPolicyRequest{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Repetitions: d.Get("repetitions").(int),
+		Steps:       escalationPolicyStepsFromState(d),
+	}
+
+	resource, err := ac.EscalationPolicies().Create(ctx, d.Get("team_id").(string), r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.ID)
+
+	if err := convertEscalationPolicyToState(resource, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func updateResourceFireHydrantEscalationPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r := firehydrant.UpdateEscalationPolicyRequest{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Repetitions: d.Get("repetitions").(int),
+		Steps:       escalationPolicyStepsFromState(d),
+	}
+
+	_, err := ac.EscalationPolicies().Update(ctx, d.Get("team_id").(string), d.Id(), r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func deleteResourceFireHydrantEscalationPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	err := ac.EscalationPolicies().Delete(ctx, d.Get("team_id").(string), d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return diag.Diagnostics{}
+}
+
+func escalationPolicyStepsFromState(d *schema.ResourceData) []firehydrant.EscalationPolicyStep {
+	steps := []firehydrant.EscalationPolicyStep{}
+
+	for _, step := range d.Get("steps").([]interface{}) {
+		s := step.(map[string]interface{})
+
+		targets := []firehydrant.EscalationPolicyTarget{}
+		for _, target := range s["targets"].(*schema.Set).List() {
+			t := target.(map[string]interface{})
+			targets = append(targets, firehydrant.EscalationPolicyTarget{
+				Type: t["type"].(string),
+				ID:   t["id"].(string),
+			})
+		}
+
+		steps = append(steps, firehydrant.EscalationPolicyStep{
+			Timeout: s["timeout"].(string),
+			Targets: targets,
+		})
+	}
+
+	return steps
+}
+
+func convertEscalationPolicyToState(policy *firehydrant.EscalationPolicyResponse, d *schema.ResourceData) error {
+	attributes := map[string]interface{}{
+		"name":        policy.Name,
+		"description": policy.Description,
+		"repetitions": policy.Repetitions,
+	}
+
+	if err := setAttributesFromMap(d, attributes); err != nil {
+		return err
+	}
+
+	steps := make([]interface{}, len(policy.Steps))
+	for index, s := range policy.Steps {
+		targets := make([]interface{}, len(s.Targets))
+		for i, t := range s.Targets {
+			targets[i] = map[string]interface{}{
+				"type": t.Type,
+				"id":   t.ID,
+			}
+		}
+
+		steps[index] = map[string]interface{}{
+			"timeout": s.Timeout,
+			"targets": targets,
+		}
+	}
+
+	return d.Set("steps", steps)
+}
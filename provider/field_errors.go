@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// collectionFieldPattern matches a field error about one item of a nested collection, such as
+// services[1].id or services.1, capturing the collection and the item's index
+var collectionFieldPattern = regexp.MustCompile(`^(\w+)(?:\[(\d+)\]|\.(\d+))(?:\.\w+)*$`)
+
+// fieldErrorDiagnostics reports each field FireHydrant rejected in a request as its own diagnostic, so
+// that a large apply shows every problem at once. items has the IDs of the items sent in each nested
+// collection, in the order they were sent, so that an error about services[1] can name that service
+// instead of its position. Errors without field errors are reported as they are
+func fieldErrorDiagnostics(err error, items map[string][]string) diag.Diagnostics {
+	var apiErr *firehydrant.APIError
+	if !errors.As(err, &apiErr) || len(apiErr.FieldErrors) == 0 {
+		return diag.FromErr(err)
+	}
+
+	ds := diag.Diagnostics{}
+	for _, fieldErr := range apiErr.FieldErrors {
+		ds = append(ds, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fieldErrorSummary(fieldErr, items),
+			Detail:   err.Error(),
+		})
+	}
+
+	return ds
+}
+
+func fieldErrorSummary(fieldErr firehydrant.FieldError, items map[string][]string) string {
+	match := collectionFieldPattern.FindStringSubmatch(fieldErr.Field)
+	if match == nil {
+		return fmt.Sprintf("%s %s", fieldErr.Field, fieldErr.Message)
+	}
+
+	index := match[2]
+	if index == "" {
+		index = match[3]
+	}
+
+	i, err := strconv.Atoi(index)
+	ids := items[match[1]]
+	if err != nil || i >= len(ids) {
+		return fmt.Sprintf("%s %s", fieldErr.Field, fieldErr.Message)
+	}
+
+	return fmt.Sprintf("%s %q %s", match[1], ids[i], fieldErr.Message)
+}
+
+// externalResourceIDs gives the remote IDs of external resources for naming them in field errors
+func externalResourceIDs(resources []firehydrant.ExternalResource) []string {
+	ids := make([]string, len(resources))
+	for index, resource := range resources {
+		ids[index] = resource.RemoteID
+	}
+
+	return ids
+}
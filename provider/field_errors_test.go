@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFieldErrorDiagnostics(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"error": "Invalid request", "errors": [
+			{"field": "services[1].id", "message": "does not exist"},
+			{"field": "external_resources.0", "message": "is not connected"},
+			{"field": "services[5]", "message": "is a duplicate"},
+			{"field": "name", "message": "can't be blank"}
+		]}`))
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL), firehydrant.WithRetries(0, 0))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceFunctionality().Schema, map[string]interface{}{
+		"name":     "Checkout",
+		"services": []interface{}{map[string]interface{}{"id": "service-1"}, map[string]interface{}{"id": "service-2"}},
+		"external_resources": []interface{}{
+			map[string]interface{}{"connection_type": "backstage", "remote_id": "component:checkout"},
+		},
+	})
+
+	diags := createResourceFireHydrantFunctionality(context.TODO(), d, ac)
+	// Services are sent in the set's order, which is not the order they were written in
+	rejected := functionalityServicesFromSet(d.Get("services").(*schema.Set))[1].ID
+
+	summaries := []string{}
+	for _, diagnostic := range diags {
+		summaries = append(summaries, diagnostic.Summary)
+	}
+
+	expected := []string{
+		fmt.Sprintf("services %q does not exist", rejected),
+		`external_resources "component:checkout" is not connected`,
+		"services[5] is a duplicate",
+		"name can't be blank",
+	}
+	if !reflect.DeepEqual(expected, summaries) {
+		t.Fatalf("Expected %q, Got: %q for the diagnostics", expected, summaries)
+	}
+}
@@ -2,13 +2,19 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -42,8 +48,216 @@ func TestAccFunctionalities(t *testing.T) {
 					testFunctionalityExists("firehydrant_functionality.terraform-acceptance-test-functionality"),
 					resource.TestCheckResourceAttr("firehydrant_functionality.terraform-acceptance-test-functionality", "name", rNameUpdated),
 					resource.TestCheckResourceAttr("firehydrant_functionality.terraform-acceptance-test-functionality", "services.#", "1"),
-					resource.TestCheckResourceAttrSet("firehydrant_functionality.terraform-acceptance-test-functionality", "services.0.id"),
-					resource.TestCheckResourceAttr("firehydrant_functionality.terraform-acceptance-test-functionality", "services.0.name", "test service from terraform"),
+					resource.TestCheckTypeSetElemNestedAttrs("firehydrant_functionality.terraform-acceptance-test-functionality", "services.*", map[string]string{
+						"name": "test service from terraform",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestFunctionalityServicesIgnoreOrder(t *testing.T) {
+	services := func(ids ...string) *schema.Set {
+		d := schema.TestResourceDataRaw(t, resourceFunctionality().Schema, map[string]interface{}{
+			"name":     "functionality",
+			"services": testFunctionalityServices(ids...),
+		})
+		return d.Get("services").(*schema.Set)
+	}
+
+	configured := services("service-1", "service-2", "service-3")
+	if shuffled := services("service-3", "service-1", "service-2"); !configured.Equal(shuffled) {
+		t.Fatalf("Expected reordered services to be the same, Got: %v and %v", configured.List(), shuffled.List())
+	}
+
+	// The name FireHydrant fills in does not change a service
+	read := schema.NewSet(hashFunctionalityService, []interface{}{
+		map[string]interface{}{"id": "service-2", "name": "Service 2"},
+		map[string]interface{}{"id": "service-3", "name": "Service 3"},
+		map[string]interface{}{"id": "service-1", "name": "Service 1"},
+	})
+	if configured.Difference(read).Len() != 0 || read.Difference(configured).Len() != 0 {
+		t.Fatalf("Expected services read from FireHydrant to match, Got: %v and %v", configured.List(), read.List())
+	}
+
+	if deduped := services("service-1", "service-1"); deduped.Len() != 1 {
+		t.Fatalf("Expected duplicate services to be combined, Got: %v", deduped.List())
+	}
+
+	changed := services("service-1", "service-4", "service-3")
+	if added := changed.Difference(configured).List(); len(added) != 1 || added[0].(map[string]interface{})["id"] != "service-4" {
+		t.Fatalf("Expected service-4 to be added, Got: %v", added)
+	}
+	if removed := configured.Difference(changed).List(); len(removed) != 1 || removed[0].(map[string]interface{})["id"] != "service-2" {
+		t.Fatalf("Expected service-2 to be removed, Got: %v", removed)
+	}
+}
+
+func TestUpdateFunctionalityExternalResources(t *testing.T) {
+	var body map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method == "PATCH" {
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Errorf("Received error decoding the update: %s", err.Error())
+			}
+		}
+		w.Write([]byte(`{"id": "test-functionality-id", "name": "functionality", "external_resources": [
+			{"connection_type": "backstage", "connection_id": "catalog-connection", "remote_id": "component:checkout", "remote_url": "https://backstage.example.com/checkout"},
+			{"connection_type": "opsgenie", "remote_id": "created-by-firehydrant"}
+		]}`))
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	hash := hashExternalResource(map[string]interface{}{"connection_type": "pager_duty", "remote_id": "PABC123"})
+	state := &terraform.InstanceState{
+		ID: "test-functionality-id",
+		Attributes: map[string]string{
+			"id":                   "test-functionality-id",
+			"name":                 "functionality",
+			"external_resources.#": "1",
+			fmt.Sprintf("external_resources.%d.connection_type", hash): "pager_duty",
+			fmt.Sprintf("external_resources.%d.connection_id", hash):   "",
+			fmt.Sprintf("external_resources.%d.remote_id", hash):       "PABC123",
+			fmt.Sprintf("external_resources.%d.remote_url", hash):      "",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "functionality",
+		"external_resources": []interface{}{
+			map[string]interface{}{"connection_type": "backstage", "connection_id": "catalog-connection", "remote_id": "component:checkout"},
+		},
+	})
+
+	r := resourceFunctionality()
+	diff, err := r.Diff(context.TODO(), state, config, ac)
+	if err != nil {
+		t.Fatalf("Received error planning the update: %s", err.Error())
+	}
+
+	newState, diags := r.Apply(context.TODO(), state, diff, ac)
+	if diags.HasError() {
+		t.Fatalf("Received error updating the functionality: %+v", diags)
+	}
+
+	// The new external resource is added and the one taken out of the configuration removed, while the
+	// one FireHydrant linked on its own is never sent
+	expected := []interface{}{
+		map[string]interface{}{"connection_type": "backstage", "connection_id": "catalog-connection", "remote_id": "component:checkout"},
+		map[string]interface{}{"connection_type": "pager_duty", "remote_id": "PABC123", "remove": true},
+	}
+	if !reflect.DeepEqual(expected, body["external_resources"]) {
+		t.Fatalf("Expected %+v, Got: %+v for the external resources update", expected, body["external_resources"])
+	}
+
+	if got := newState.Attributes["external_resources.#"]; got != "1" {
+		t.Fatalf("Expected only the managed external resource in state, Got: %s", got)
+	}
+}
+
+func TestFunctionalityValidateReferences(t *testing.T) {
+	var requested []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requested = append(requested, req.URL.Path)
+		if req.URL.Path == "/services/mistyped-service" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail": "Record not found"}`))
+			return
+		}
+		w.Write([]byte(`{"id": "service-1", "name": "Service 1"}`))
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":     "functionality",
+		"services": testFunctionalityServices("service-1", "mistyped-service"),
+	})
+
+	r := resourceFunctionality()
+	if _, err := r.Diff(context.TODO(), nil, config, &providerMeta{Client: ac}); err != nil {
+		t.Fatalf("Expected services not to be checked without validate_references, Got: %s", err.Error())
+	}
+	if len(requested) != 0 {
+		t.Fatalf("Expected no requests without validate_references, Got: %v", requested)
+	}
+
+	_, err = r.Diff(context.TODO(), nil, config, &providerMeta{Client: ac, validateReferences: true})
+	if err == nil || !strings.Contains(err.Error(), "service mistyped-service in services does not exist") {
+		t.Fatalf("Expected an error naming the missing service, Got: %v", err)
+	}
+}
+
+func testFunctionalityServices(ids ...string) []interface{} {
+	services := make([]interface{}, len(ids))
+	for index, id := range ids {
+		services[index] = map[string]interface{}{"id": id}
+	}
+
+	return services
+}
+
+func TestFunctionalityDataSourceBySlug(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/functionalities":
+			if got := req.URL.Query().Get("query"); got != "checkout" {
+				t.Errorf("Expected the slug to be searched for, Got: %q", got)
+			}
+			w.Write([]byte(`{"data": [
+				{"id": "checkout-flow-id", "name": "Checkout", "slug": "checkout-flow"},
+				{"id": "checkout-id", "name": "Checkout", "slug": "checkout"}
+			]}`))
+		case "/functionalities/checkout-id":
+			w.Write([]byte(`{"id": "checkout-id", "name": "Checkout", "slug": "checkout", "services": [{"id": "service-1"}]}`))
+		default:
+			t.Errorf("Unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceFunctionality().Schema, map[string]interface{}{"slug": "checkout"})
+	if diags := dataFireHydrantFunctionality(context.TODO(), d, ac); diags.HasError() {
+		t.Fatalf("Received error reading functionality: %+v", diags)
+	}
+
+	if d.Id() != "checkout-id" {
+		t.Fatalf("Expected checkout-id, Got: %s for the functionality found", d.Id())
+	}
+
+	if got := d.Get("service_ids"); !reflect.DeepEqual([]interface{}{"service-1"}, got) {
+		t.Fatalf("Expected the functionality's services to be returned, Got: %+v", got)
+	}
+}
+
+func TestAccFunctionalityDataSource(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testFireHydrantIsSetup(t) },
+		ProviderFactories: defaultProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testFunctionalityDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.firehydrant_functionality.by_name", "id", "firehydrant_functionality.terraform-acceptance-test-functionality", "id"),
+					resource.TestCheckResourceAttrPair("data.firehydrant_functionality.by_id", "name", "firehydrant_functionality.terraform-acceptance-test-functionality", "name"),
+					resource.TestCheckResourceAttr("data.firehydrant_functionality.by_name", "service_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.firehydrant_functionality.by_name", "service_ids.0", "firehydrant_service.service", "id"),
 				),
 			},
 		},
@@ -78,6 +292,20 @@ func testFunctionalityConfigWithService(rName string) string {
 	return fmt.Sprintf(testFunctionalityWithService, rName)
 }
 
+const testFunctionalityDataSource = `
+data "firehydrant_functionality" "by_name" {
+	name = firehydrant_functionality.terraform-acceptance-test-functionality.name
+}
+
+data "firehydrant_functionality" "by_id" {
+	functionality_id = firehydrant_functionality.terraform-acceptance-test-functionality.id
+}
+`
+
+func testFunctionalityDataSourceConfig(rName string) string {
+	return testFunctionalityConfigWithService(rName) + testFunctionalityDataSource
+}
+
 func testFunctionalityExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -129,10 +357,63 @@ func testFunctionalityDoesNotExist(resourceName string) resource.TestCheckFunc {
 			return fmt.Errorf("The functionality existed, when it should not")
 		}
 
-		if _, isNotFound := err.(firehydrant.NotFound); !isNotFound {
+		if !firehydrant.IsNotFound(err) {
 			return err
 		}
 
 		return nil
 	}
 }
+
+func TestUpdateFunctionalityOwner(t *testing.T) {
+	var body map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body = nil
+		if req.Method == "PATCH" {
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Errorf("Received error decoding the update: %s", err.Error())
+			}
+		}
+		w.Write([]byte(`{"id": "test-functionality-id", "name": "functionality", "owner": {"id": "set-outside-terraform"}}`))
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	state := &terraform.InstanceState{
+		ID: "test-functionality-id",
+		Attributes: map[string]string{
+			"id":          "test-functionality-id",
+			"name":        "functionality",
+			"description": "",
+			"owner_id":    "set-outside-terraform",
+		},
+	}
+
+	r := resourceFunctionality()
+	update := func(config map[string]interface{}) {
+		diff, err := r.Diff(context.TODO(), state, terraform.NewResourceConfigRaw(config), ac)
+		if err != nil {
+			t.Fatalf("Received error planning the update: %s", err.Error())
+		}
+
+		if _, diags := r.Apply(context.TODO(), state, diff, ac); diags.HasError() {
+			t.Fatalf("Received error updating the functionality: %+v", diags)
+		}
+	}
+
+	// Without owner_id in the configuration, the owner set outside of Terraform is left alone
+	update(map[string]interface{}{"name": "functionality", "description": "Checkout"})
+	if _, ok := body["owner"]; ok {
+		t.Fatalf("Expected the owner not to be sent, Got: %+v", body["owner"])
+	}
+
+	update(map[string]interface{}{"name": "functionality", "owner_id": "checkout-team"})
+	expected := map[string]interface{}{"id": "checkout-team"}
+	if !reflect.DeepEqual(expected, body["owner"]) {
+		t.Fatalf("Expected %+v, Got: %+v for the owner", expected, body["owner"])
+	}
+}
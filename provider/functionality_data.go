@@ -2,6 +2,8 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
 
@@ -14,43 +16,129 @@ func dataSourceFunctionality() *schema.Resource {
 		ReadContext: dataFireHydrantFunctionality,
 		Schema: map[string]*schema.Schema{
 			"functionality_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"functionality_id", "name", "slug"},
 			},
 			"name": {
 				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
 			},
 			"description": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"slug": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Slugs are unique, so looking a functionality up by its slug never matches more than one.",
+			},
+			"service_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"owner_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the team that owns the functionality, or empty when it has no owner.",
+			},
 		},
 	}
 }
 
 func dataFireHydrantFunctionality(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ac := m.(firehydrant.Client)
-	id := d.Get("functionality_id").(string)
 
-	r, err := ac.GetFunctionality(ctx, id)
-	if err != nil {
+	var r *firehydrant.FunctionalityResponse
+	if id := d.Get("functionality_id").(string); id != "" {
+		functionality, err := ac.GetFunctionality(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		r = functionality
+	} else if slug := d.Get("slug").(string); slug != "" {
+		functionality, err := findFunctionalityBySlug(ctx, ac, slug)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		r = functionality
+	} else {
+		functionality, err := findFunctionalityByName(ctx, ac, d.Get("name").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		r = functionality
+	}
+
+	serviceIDs := make([]string, len(r.Services))
+	for index, s := range r.Services {
+		serviceIDs[index] = s.ID
+	}
+
+	attributes := map[string]interface{}{
+		"functionality_id": r.ID,
+		"name":             r.Name,
+		"description":      r.Description,
+		"slug":             r.Slug,
+		"service_ids":      serviceIDs,
+		"owner_id":         functionalityOwnerID(r),
+	}
+	if err := setAttributesFromMap(d, attributes); err != nil {
 		return diag.FromErr(err)
 	}
 
-	var ds diag.Diagnostics
-	env := map[string]string{
-		"name":        r.Name,
-		"description": r.Description,
+	d.SetId(r.ID)
+
+	return diag.Diagnostics{}
+}
+
+// findFunctionalityByName resolves a functionality name using the functionality search, which matches more than the exact name
+func findFunctionalityByName(ctx context.Context, ac firehydrant.Client, name string) (*firehydrant.FunctionalityResponse, error) {
+	functionalities, err := ac.ListFunctionalities(ctx, &firehydrant.FunctionalityQuery{Query: name})
+	if err != nil {
+		return nil, err
 	}
 
-	for key, val := range env {
-		if err := d.Set(key, val); err != nil {
-			return diag.FromErr(err)
+	var matches []firehydrant.FunctionalityResponse
+	for _, functionality := range functionalities.Functionalities {
+		if functionality.Name == name {
+			matches = append(matches, functionality)
 		}
 	}
 
-	d.SetId(r.ID)
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("could not find a functionality named %q", name)
+	case 1:
+		// The search results may leave out some of a functionality's details, such as its services
+		return ac.GetFunctionality(ctx, matches[0].ID)
+	}
+
+	slugs := make([]string, len(matches))
+	for index, functionality := range matches {
+		slugs[index] = functionality.Slug
+	}
+
+	return nil, fmt.Errorf("found %d functionalities named %q (slugs: %s), set slug instead of name to pick one", len(matches), name, strings.Join(slugs, ", "))
+}
+
+// findFunctionalityBySlug resolves a slug using the functionality search, which also matches names and partial slugs
+func findFunctionalityBySlug(ctx context.Context, ac firehydrant.Client, slug string) (*firehydrant.FunctionalityResponse, error) {
+	functionalities, err := ac.ListFunctionalities(ctx, &firehydrant.FunctionalityQuery{Query: slug})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, functionality := range functionalities.Functionalities {
+		if strings.EqualFold(functionality.Slug, slug) {
+			// The search results may leave out some of a functionality's details, such as its services
+			return ac.GetFunctionality(ctx, functionality.ID)
+		}
+	}
 
-	return ds
+	return nil, fmt.Errorf("could not find a functionality with the slug %q", slug)
 }
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -14,6 +15,7 @@ func resourceFunctionality() *schema.Resource {
 		UpdateContext: updateResourceFireHydrantFunctionality,
 		ReadContext:   readResourceFireHydrantFunctionality,
 		DeleteContext: deleteResourceFireHydrantFunctionality,
+		CustomizeDiff: customizeDiffFireHydrantFunctionality,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -23,12 +25,22 @@ func resourceFunctionality() *schema.Resource {
 				Required: true,
 			},
 			"description": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressMarkdownWhitespaceDiff,
+				Description:      "Markdown describing the functionality. Differences only in trailing whitespace or line endings are ignored, since FireHydrant normalizes them.",
+			},
+			"owner_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the team that owns this functionality, which incidents impacting it are routed to. An owner set outside of Terraform is kept until this is set.",
 			},
 			"services": {
-				Type:     schema.TypeList,
-				Optional: true,
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Set:         hashFunctionalityService,
+				Description: "The services that make up this functionality. Their order does not matter.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -42,6 +54,13 @@ func resourceFunctionality() *schema.Resource {
 					},
 				},
 			},
+			"external_resources": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Set:         hashExternalResource,
+				Description: "Objects in other tools linked to this functionality, such as entries in a service catalog. Only the external resources listed here are managed; any others FireHydrant links to the functionality are left alone.",
+				Elem:        externalResourceResource(),
+			},
 		},
 	}
 }
@@ -49,10 +68,10 @@ func resourceFunctionality() *schema.Resource {
 func readResourceFireHydrantFunctionality(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ac := m.(firehydrant.Client)
 	r, err := ac.GetFunctionality(ctx, d.Id())
-	if err != nil {
-		return diag.FromErr(err)
+	if firehydrant.IsNotFound(err) {
+		d.SetId("")
+		return diag.Diagnostics{}
 	}
-
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -61,6 +80,7 @@ func readResourceFireHydrantFunctionality(ctx context.Context, d *schema.Resourc
 	svc := map[string]string{
 		"name":        r.Name,
 		"description": r.Description,
+		"owner_id":    functionalityOwnerID(r),
 	}
 
 	for key, val := range svc {
@@ -69,14 +89,11 @@ func readResourceFireHydrantFunctionality(ctx context.Context, d *schema.Resourc
 		}
 	}
 
-	svcs := make([]interface{}, len(r.Services))
-	for index, s := range r.Services {
-		svcs[index] = map[string]interface{}{
-			"id":   s.ID,
-			"name": s.Name,
-		}
+	if err := d.Set("services", functionalityServicesToState(r.Services)); err != nil {
+		return diag.FromErr(err)
 	}
-	if err := d.Set("services", svcs); err != nil {
+
+	if err := d.Set("external_resources", managedExternalResources(d, r.ExternalResources)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -88,22 +105,19 @@ func createResourceFireHydrantFunctionality(ctx context.Context, d *schema.Resou
 	name, description := d.Get("name").(string), d.Get("description").(string)
 
 	r := firehydrant.CreateFunctionalityRequest{
-		Name:        name,
-		Description: description,
-		Services:    []firehydrant.FunctionalityService{},
+		Name:              name,
+		Description:       description,
+		Services:          functionalityServicesFromSet(d.Get("services").(*schema.Set)),
+		ExternalResources: externalResourcesFromSet(d.Get("external_resources").(*schema.Set)),
 	}
 
-	services := d.Get("services").([]interface{})
-	for _, svc := range services {
-		data := svc.(map[string]interface{})
-		r.Services = append(r.Services, firehydrant.FunctionalityService{
-			ID: data["id"].(string),
-		})
+	if ownerID := d.Get("owner_id").(string); ownerID != "" {
+		r.Owner = &firehydrant.ServiceTeam{ID: ownerID}
 	}
 
 	resource, err := ac.CreateFunctionality(ctx, r)
 	if err != nil {
-		return diag.FromErr(err)
+		return fieldErrorDiagnostics(err, functionalityRequestItems(r.Services, r.ExternalResources))
 	}
 
 	d.SetId(resource.ID)
@@ -111,21 +125,18 @@ func createResourceFireHydrantFunctionality(ctx context.Context, d *schema.Resou
 	attributes := map[string]interface{}{
 		"name":        resource.Name,
 		"description": resource.Description,
+		"owner_id":    functionalityOwnerID(resource),
 	}
 
 	if err := setAttributesFromMap(d, attributes); err != nil {
 		return diag.FromErr(err)
 	}
 
-	svcs := make([]interface{}, len(resource.Services))
-	for index, s := range resource.Services {
-		svcs[index] = map[string]interface{}{
-			"id":   s.ID,
-			"name": s.Name,
-		}
+	if err := d.Set("services", functionalityServicesToState(resource.Services)); err != nil {
+		return diag.FromErr(err)
 	}
 
-	if err := d.Set("services", svcs); err != nil {
+	if err := d.Set("external_resources", managedExternalResources(d, resource.ExternalResources)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -137,35 +148,35 @@ func updateResourceFireHydrantFunctionality(ctx context.Context, d *schema.Resou
 	ac := m.(firehydrant.Client)
 	id := d.Id()
 	name := d.Get("name").(string)
-	description := d.Get("description").(string)
 
 	r := firehydrant.UpdateFunctionalityRequest{
-		Name:        name,
-		Description: description,
+		Name:     name,
+		Services: functionalityServicesFromSet(d.Get("services").(*schema.Set)),
 	}
 
-	services := d.Get("services").([]interface{})
-	for _, svc := range services {
-		data := svc.(map[string]interface{})
-		r.Services = append(r.Services, firehydrant.FunctionalityService{
-			ID: data["id"].(string),
-		})
+	if d.HasChange("description") {
+		r.Description = firehydrant.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("owner_id") {
+		r.Owner = &firehydrant.ServiceTeam{ID: d.Get("owner_id").(string)}
+	}
+
+	// Only the external resources that changed are sent, so ones FireHydrant linked on its own are kept
+	if d.HasChange("external_resources") {
+		r.ExternalResources = externalResourcesChanges(d)
 	}
 
 	functionality, err := ac.UpdateFunctionality(ctx, id, r)
 	if err != nil {
-		return diag.FromErr(err)
+		return fieldErrorDiagnostics(err, functionalityRequestItems(r.Services, r.ExternalResources))
 	}
 
-	svcs := make([]interface{}, len(functionality.Services))
-	for index, s := range functionality.Services {
-		svcs[index] = map[string]interface{}{
-			"id":   s.ID,
-			"name": s.Name,
-		}
+	if err := d.Set("services", functionalityServicesToState(functionality.Services)); err != nil {
+		return diag.FromErr(err)
 	}
 
-	if err := d.Set("services", svcs); err != nil {
+	if err := d.Set("external_resources", managedExternalResources(d, functionality.ExternalResources)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -184,3 +195,76 @@ func deleteResourceFireHydrantFunctionality(ctx context.Context, d *schema.Resou
 	d.SetId("")
 	return diag.Diagnostics{}
 }
+
+func functionalityOwnerID(functionality *firehydrant.FunctionalityResponse) string {
+	if functionality.Owner == nil {
+		return ""
+	}
+
+	return functionality.Owner.ID
+}
+
+// customizeDiffFireHydrantFunctionality checks that newly listed services exist when the provider's
+// validate_references is enabled, since FireHydrant only rejects a missing one with a generic error
+func customizeDiffFireHydrantFunctionality(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	meta, ok := m.(*providerMeta)
+	if !ok || !meta.validateReferences || !d.NewValueKnown("services") {
+		return nil
+	}
+
+	o, n := d.GetChange("services")
+	for _, service := range functionalityServicesFromSet(n.(*schema.Set).Difference(o.(*schema.Set))) {
+		if _, err := meta.Services().Get(ctx, service.ID); err != nil {
+			if firehydrant.IsNotFound(err) {
+				return fmt.Errorf("service %s in services does not exist, check for a mistyped ID: %w", service.ID, err)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// hashFunctionalityService identifies a service by its ID alone, so that the name FireHydrant
+// fills in does not make a service look changed
+func hashFunctionalityService(v interface{}) int {
+	return schema.HashString(v.(map[string]interface{})["id"])
+}
+
+func functionalityServicesFromSet(services *schema.Set) []firehydrant.FunctionalityService {
+	r := []firehydrant.FunctionalityService{}
+	for _, svc := range services.List() {
+		data := svc.(map[string]interface{})
+		r = append(r, firehydrant.FunctionalityService{
+			ID: data["id"].(string),
+		})
+	}
+
+	return r
+}
+
+func functionalityServicesToState(services []firehydrant.ServiceResponse) []interface{} {
+	svcs := make([]interface{}, len(services))
+	for index, s := range services {
+		svcs[index] = map[string]interface{}{
+			"id":   s.ID,
+			"name": s.Name,
+		}
+	}
+
+	return svcs
+}
+
+// functionalityRequestItems names the services and external resources sent for a functionality, for
+// pointing out which of them FireHydrant rejected
+func functionalityRequestItems(services []firehydrant.FunctionalityService, externalResources []firehydrant.ExternalResource) map[string][]string {
+	serviceIDs := make([]string, len(services))
+	for index, service := range services {
+		serviceIDs[index] = service.ID
+	}
+
+	return map[string][]string{
+		"services":           serviceIDs,
+		"external_resources": externalResourceIDs(externalResources),
+	}
+}
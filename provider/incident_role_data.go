@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIncidentRole() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataFireHydrantIncidentRole,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"summary": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataFireHydrantIncidentRole(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	name := d.Get("name").(string)
+
+	roles, err := ac.IncidentRoles().List(ctx, &firehydrant.IncidentRoleQuery{Query: name})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The query is a fuzzy search, so only an active role with exactly this name counts
+	var r *firehydrant.IncidentRoleResponse
+	for i, role := range roles.IncidentRoles {
+		if role.Name == name && role.DiscardedAt == nil {
+			r = &roles.IncidentRoles[i]
+			break
+		}
+	}
+	if r == nil {
+		return diag.FromErr(fmt.Errorf("could not find an incident role named %q", name))
+	}
+
+	var ds diag.Diagnostics
+	role := map[string]string{
+		"summary":     r.Summary,
+		"description": r.Description,
+	}
+
+	for key, val := range role {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(r.ID)
+
+	return ds
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIncidentRole() *schema.Resource {
+	return &schema.Resource{
+		Description:   "FireHydrant incident roles are the responsibilities people take on during an incident, such as commander or scribe.",
+		CreateContext: createResourceFireHydrantIncidentRole,
+		UpdateContext: updateResourceFireHydrantIncidentRole,
+		ReadContext:   readResourceFireHydrantIncidentRole,
+		DeleteContext: deleteResourceFireHydrantIncidentRole,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"summary": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func readResourceFireHydrantIncidentRole(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	r, err := ac.IncidentRoles().Get(ctx, d.Id())
+	if firehydrant.IsNotFound(err) {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Deleted roles are archived rather than removed, so they have to be treated as gone here
+	if r.DiscardedAt != nil {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+
+	if err := convertIncidentRoleToState(r, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func createResourceFireHydrantIncidentRole(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r := firehydrant.CreateIncidentRoleRequest{
+		Name:        d.Get("name").(string),
+		Summary:     d.Get("summary").(string),
+		Description: d.Get("description").(string),
+	}
+
+	resource, err := ac.IncidentRoles().Create(ctx, r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.ID)
+
+	if err := convertIncidentRoleToState(resource, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func updateResourceFireHydrantIncidentRole(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r := firehydrant.UpdateIncidentRoleRequest{
+		Name:        d.Get("name").(string),
+		Summary:     d.Get("summary").(string),
+		Description: d.Get("description").(string),
+	}
+
+	_, err := ac.IncidentRoles().Update(ctx, d.Id(), r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func deleteResourceFireHydrantIncidentRole(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	// A role that is already gone, such as one archived in the UI, is as deleted as it is going to get
+	err := ac.IncidentRoles().Delete(ctx, d.Id())
+	if err != nil && !firehydrant.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return diag.Diagnostics{}
+}
+
+func convertIncidentRoleToState(role *firehydrant.IncidentRoleResponse, d *schema.ResourceData) error {
+	attributes := map[string]interface{}{
+		"name":        role.Name,
+		"summary":     role.Summary,
+		"description": role.Description,
+	}
+
+	return setAttributesFromMap(d, attributes)
+}
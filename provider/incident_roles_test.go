@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIncidentRoles(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testFireHydrantIsSetup(t) },
+		ProviderFactories: defaultProviderFactories(),
+		CheckDestroy:      testIncidentRoleDoesNotExist("firehydrant_incident_role.terraform-acceptance-test-role"),
+		Steps: []resource.TestStep{
+			{
+				Config: testIncidentRoleConfig(rName, "first summary"),
+				Check: resource.ComposeTestCheckFunc(
+					testIncidentRoleExists("firehydrant_incident_role.terraform-acceptance-test-role"),
+					resource.TestCheckResourceAttr("firehydrant_incident_role.terraform-acceptance-test-role", "name", rName),
+					resource.TestCheckResourceAttr("firehydrant_incident_role.terraform-acceptance-test-role", "summary", "first summary"),
+					resource.TestCheckResourceAttrPair("data.firehydrant_incident_role.by_name", "id", "firehydrant_incident_role.terraform-acceptance-test-role", "id"),
+				),
+			},
+			{
+				Config: testIncidentRoleConfig(rName, "updated summary"),
+				Check: resource.ComposeTestCheckFunc(
+					testIncidentRoleExists("firehydrant_incident_role.terraform-acceptance-test-role"),
+					resource.TestCheckResourceAttr("firehydrant_incident_role.terraform-acceptance-test-role", "summary", "updated summary"),
+				),
+			},
+		},
+	})
+}
+
+const testIncidentRoleConfigTemplate = `
+resource "firehydrant_incident_role" "terraform-acceptance-test-role" {
+	name        = "%s"
+	summary     = "%s"
+	description = "A role created by the acceptance tests"
+}
+
+data "firehydrant_incident_role" "by_name" {
+	name = firehydrant_incident_role.terraform-acceptance-test-role.name
+}
+`
+
+func testIncidentRoleConfig(rName, summary string) string {
+	return fmt.Sprintf(testIncidentRoleConfigTemplate, rName, summary)
+}
+
+func testIncidentRoleExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		role, err := c.IncidentRoles().Get(context.TODO(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if expected, got := rs.Primary.Attributes["summary"], role.Summary; expected != got {
+			return fmt.Errorf("Expected summary %s, got %s", expected, got)
+		}
+
+		return nil
+	}
+}
+
+func testIncidentRoleDoesNotExist(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return nil
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		// Deleted roles are archived, so an archived role counts as not existing
+		role, err := c.IncidentRoles().Get(context.TODO(), rs.Primary.ID)
+		if role != nil && role.DiscardedAt == nil {
+			return fmt.Errorf("The incident role existed, when it should not")
+		}
+
+		if err != nil && !firehydrant.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIncidentType() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataFireHydrantIncidentType,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"template": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"priority": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataFireHydrantIncidentType(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	name := d.Get("name").(string)
+
+	incidentTypes, err := ac.IncidentTypes().List(ctx, &firehydrant.IncidentTypeQuery{Query: name})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The query is a fuzzy search, so only an incident type with exactly this name counts
+	var r *firehydrant.IncidentTypeResponse
+	for i, incidentType := range incidentTypes.IncidentTypes {
+		if incidentType.Name == name {
+			r = &incidentTypes.IncidentTypes[i]
+			break
+		}
+	}
+	if r == nil {
+		return diag.FromErr(fmt.Errorf("could not find an incident type named %q", name))
+	}
+
+	if err := convertIncidentTypeToState(r, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(r.ID)
+
+	return diag.Diagnostics{}
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIncidentType() *schema.Resource {
+	return &schema.Resource{
+		Description:   "FireHydrant incident types are templates that start an incident with a default severity, priority, and labels.",
+		CreateContext: createResourceFireHydrantIncidentType,
+		UpdateContext: updateResourceFireHydrantIncidentType,
+		ReadContext:   readResourceFireHydrantIncidentType,
+		DeleteContext: deleteResourceFireHydrantIncidentType,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"template": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"severity": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateSeveritySlug,
+							Description:  "The slug of the severity incidents of this type start with.",
+						},
+						"priority": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateSeveritySlug,
+							Description:  "The slug of the priority incidents of this type start with.",
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readResourceFireHydrantIncidentType(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	r, err := ac.IncidentTypes().Get(ctx, d.Id())
+	if firehydrant.IsNotFound(err) {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := convertIncidentTypeToState(r, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func createResourceFireHydrantIncidentType(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	template := incidentTypeTemplateFromState(d)
+	if err := checkIncidentTypeTemplate(ctx, ac, template); err != nil {
+		return diag.FromErr(err)
+	}
+
+	r := firehydrant.CreateIncidentTypeRequest{
+		Name:     d.Get("name").(string),
+		Template: template,
+	}
+
+	resource, err := ac.IncidentTypes().Create(ctx, r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.ID)
+
+	if err := convertIncidentTypeToState(resource, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func updateResourceFireHydrantIncidentType(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	template := incidentTypeTemplateFromState(d)
+	if err := checkIncidentTypeTemplate(ctx, ac, template); err != nil {
+		return diag.FromErr(err)
+	}
+
+	r := firehydrant.UpdateIncidentTypeRequest{
+		Name:     d.Get("name").(string),
+		Template: template,
+	}
+
+	_, err := ac.IncidentTypes().Update(ctx, d.Id(), r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func deleteResourceFireHydrantIncidentType(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	err := ac.IncidentTypes().Delete(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return diag.Diagnostics{}
+}
+
+func incidentTypeTemplateFromState(d *schema.ResourceData) firehydrant.IncidentTypeTemplate {
+	t := d.Get("template").([]interface{})[0].(map[string]interface{})
+
+	labels := map[string]string{}
+	for key, value := range t["labels"].(map[string]interface{}) {
+		labels[key] = value.(string)
+	}
+
+	return firehydrant.IncidentTypeTemplate{
+		Description: t["description"].(string),
+		Severity:    t["severity"].(string),
+		Priority:    t["priority"].(string),
+		Labels:      labels,
+	}
+}
+
+// checkIncidentTypeTemplate makes sure the severity and priority a template refers to exist, since
+// FireHydrant only reports a template it cannot use as a generic validation failure
+func checkIncidentTypeTemplate(ctx context.Context, ac firehydrant.Client, template firehydrant.IncidentTypeTemplate) error {
+	if template.Severity != "" {
+		if _, err := ac.GetSeverity(ctx, template.Severity); err != nil {
+			if firehydrant.IsNotFound(err) {
+				return fmt.Errorf("template severity %q does not exist", template.Severity)
+			}
+			return err
+		}
+	}
+
+	if template.Priority != "" {
+		if _, err := ac.Priorities().Get(ctx, template.Priority); err != nil {
+			if firehydrant.IsNotFound(err) {
+				return fmt.Errorf("template priority %q does not exist", template.Priority)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func convertIncidentTypeToState(incidentType *firehydrant.IncidentTypeResponse, d *schema.ResourceData) error {
+	if err := d.Set("name", incidentType.Name); err != nil {
+		return err
+	}
+
+	template := map[string]interface{}{
+		"description": incidentType.Template.Description,
+		"severity":    incidentType.Template.Severity,
+		"priority":    incidentType.Template.Priority,
+		"labels":      incidentType.Template.Labels,
+	}
+
+	return d.Set("template", []interface{}{template})
+}
@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIncidentTypes(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	rNameUpdated := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testFireHydrantIsSetup(t) },
+		ProviderFactories: defaultProviderFactories(),
+		CheckDestroy:      testIncidentTypeDoesNotExist("firehydrant_incident_type.terraform-acceptance-test-incident-type"),
+		Steps: []resource.TestStep{
+			{
+				Config: testIncidentTypeConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testIncidentTypeExists("firehydrant_incident_type.terraform-acceptance-test-incident-type"),
+					resource.TestCheckResourceAttr("firehydrant_incident_type.terraform-acceptance-test-incident-type", "name", rName),
+					resource.TestCheckResourceAttr("firehydrant_incident_type.terraform-acceptance-test-incident-type", "template.0.severity", strings.ToUpper(rName)),
+					resource.TestCheckResourceAttr("firehydrant_incident_type.terraform-acceptance-test-incident-type", "template.0.priority", strings.ToUpper(rName)),
+					resource.TestCheckResourceAttr("firehydrant_incident_type.terraform-acceptance-test-incident-type", "template.0.labels.team", "platform"),
+					resource.TestCheckResourceAttrPair("data.firehydrant_incident_type.by_name", "id", "firehydrant_incident_type.terraform-acceptance-test-incident-type", "id"),
+				),
+			},
+			{
+				Config: testIncidentTypeConfig(rNameUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testIncidentTypeExists("firehydrant_incident_type.terraform-acceptance-test-incident-type"),
+					resource.TestCheckResourceAttr("firehydrant_incident_type.terraform-acceptance-test-incident-type", "name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIncidentTypeMissingSeverity(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testFireHydrantIsSetup(t) },
+		ProviderFactories: defaultProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testIncidentTypeMissingSeverity, rName, rName),
+				ExpectError: regexp.MustCompile(`template severity "[A-Z0-9]+" does not exist`),
+			},
+		},
+	})
+}
+
+const testIncidentTypeConfigTemplate = `
+resource "firehydrant_severity" "severity" {
+	slug = upper("%s")
+}
+
+resource "firehydrant_priority" "priority" {
+	slug = upper("%s")
+}
+
+resource "firehydrant_incident_type" "terraform-acceptance-test-incident-type" {
+	name = "%s"
+
+	template {
+		description = "A database is down"
+		severity    = firehydrant_severity.severity.slug
+		priority    = firehydrant_priority.priority.slug
+		labels = {
+			team = "platform"
+		}
+	}
+}
+
+data "firehydrant_incident_type" "by_name" {
+	name = firehydrant_incident_type.terraform-acceptance-test-incident-type.name
+}
+`
+
+const testIncidentTypeMissingSeverity = `
+resource "firehydrant_incident_type" "missing-severity" {
+	name = "%s"
+
+	template {
+		severity = upper("%s")
+	}
+}
+`
+
+func testIncidentTypeConfig(rName string) string {
+	return fmt.Sprintf(testIncidentTypeConfigTemplate, rName, rName, rName)
+}
+
+func testIncidentTypeExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		incidentType, err := c.IncidentTypes().Get(context.TODO(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if expected, got := rs.Primary.Attributes["name"], incidentType.Name; expected != got {
+			return fmt.Errorf("Expected name %s, got %s", expected, got)
+		}
+
+		return nil
+	}
+}
+
+func testIncidentTypeDoesNotExist(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return nil
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		incidentType, err := c.IncidentTypes().Get(context.TODO(), rs.Primary.ID)
+		if incidentType != nil {
+			return fmt.Errorf("The incident type existed, when it should not")
+		}
+
+		if !firehydrant.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}
+}
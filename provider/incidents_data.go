@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceIncidents() *schema.Resource {
+	return &schema.Resource{
+		Description: "The incidents matching a set of filters, such as the open incidents on a service.",
+		ReadContext: dataFireHydrantIncidents,
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return incidents involving this service.",
+			},
+			"environment_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return incidents involving this environment.",
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"open", "closed"}, false),
+				Description:  "Only return incidents that are open or closed. Incidents of either are returned when not set.",
+			},
+			"incidents": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The incident's current milestone, such as started, mitigated, or resolved.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataFireHydrantIncidents(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r, err := ac.Incidents().List(ctx, &firehydrant.IncidentQuery{
+		Services:     d.Get("service_id").(string),
+		Environments: d.Get("environment_id").(string),
+		Status:       d.Get("status").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	incidents := make([]interface{}, 0)
+	for _, incident := range r.Incidents {
+		incidents = append(incidents, map[string]interface{}{
+			"id":       incident.ID,
+			"name":     incident.Name,
+			"severity": incident.Severity,
+			"status":   incident.CurrentMilestone,
+		})
+	}
+
+	if err := d.Set("incidents", incidents); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("does-not-matter")
+
+	return diag.Diagnostics{}
+}
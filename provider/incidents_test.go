@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestIncidentsDataSource(t *testing.T) {
+	var queries []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		queries = append(queries, req.URL.RawQuery)
+		if req.URL.Query().Get("page") == "1" {
+			w.Write([]byte(`{"data": [{"id": "incident-1", "name": "Checkout is down", "severity": "SEV1", "current_milestone": "started"}], "pagination": {"page": 1, "pages": 2}}`))
+			return
+		}
+		w.Write([]byte(`{"data": [{"id": "incident-2", "name": "Slow checkouts", "severity": "SEV3", "current_milestone": "mitigated"}], "pagination": {"page": 2, "pages": 2}}`))
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceIncidents().Schema, map[string]interface{}{
+		"service_id": "checkout-service",
+		"status":     "open",
+	})
+
+	if diags := dataFireHydrantIncidents(context.TODO(), d, ac); diags.HasError() {
+		t.Fatalf("Received error reading incidents: %+v", diags)
+	}
+
+	expectedQueries := []string{"page=1&services=checkout-service&status=open", "page=2&services=checkout-service&status=open"}
+	if !reflect.DeepEqual(expectedQueries, queries) {
+		t.Fatalf("Expected %v, Got: %v for the incident queries", expectedQueries, queries)
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{"id": "incident-1", "name": "Checkout is down", "severity": "SEV1", "status": "started"},
+		map[string]interface{}{"id": "incident-2", "name": "Slow checkouts", "severity": "SEV3", "status": "mitigated"},
+	}
+	if got := d.Get("incidents"); !reflect.DeepEqual(expected, got) {
+		t.Fatalf("Expected %+v, Got: %+v for incidents", expected, got)
+	}
+}
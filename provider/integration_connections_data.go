@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIntegrationConnections() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataFireHydrantIntegrationConnections,
+		Schema: map[string]*schema.Schema{
+			"connection_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return connections to this integration, such as pager_duty or opsgenie.",
+			},
+			"connections": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"connection_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataFireHydrantIntegrationConnections(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	connectionType := d.Get("connection_type").(string)
+
+	r, err := ac.Integrations().ListConnections(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	connections := make([]interface{}, 0)
+	for _, connection := range r.Connections {
+		if connectionType != "" && connection.IntegrationSlug != connectionType {
+			continue
+		}
+
+		connections = append(connections, map[string]interface{}{
+			"connection_id":   connection.ID,
+			"connection_type": connection.IntegrationSlug,
+			"name":            connection.Name,
+		})
+	}
+
+	if err := d.Set("connections", connections); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("does-not-matter")
+
+	return diag.Diagnostics{}
+}
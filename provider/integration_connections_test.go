@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestIntegrationConnectionsDataSource(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`{"data": [
+			{"id": "pd-connection", "name": "PagerDuty (production)", "integration_slug": "pager_duty"},
+			{"id": "og-connection", "name": "Opsgenie", "integration_slug": "opsgenie"}
+		]}`))
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceIntegrationConnections().Schema, map[string]interface{}{
+		"connection_type": "pager_duty",
+	})
+
+	if diags := dataFireHydrantIntegrationConnections(context.TODO(), d, ac); diags.HasError() {
+		t.Fatalf("Received error reading integration connections: %+v", diags)
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"connection_id":   "pd-connection",
+			"connection_type": "pager_duty",
+			"name":            "PagerDuty (production)",
+		},
+	}
+
+	if got := d.Get("connections"); !reflect.DeepEqual(expected, got) {
+		t.Fatalf("Expected %+v, Got: %+v for connections", expected, got)
+	}
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceOnCall() *schema.Resource {
+	return &schema.Resource{
+		Description: "Who is on call for a team right now, such as for generating a \"who to page\" reference.",
+		ReadContext: dataFireHydrantOnCall,
+		Schema: map[string]*schema.Schema{
+			"team_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"schedule_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return who is on call for this one of the team's schedules. Everyone on call for any of the team's schedules is returned when not set.",
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataFireHydrantOnCall(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	teamID := d.Get("team_id").(string)
+	scheduleID := d.Get("schedule_id").(string)
+
+	r, err := ac.Schedules().OnCall(ctx, teamID, scheduleID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	users := make([]interface{}, 0)
+	for _, user := range r.Users {
+		users = append(users, map[string]interface{}{
+			"id":    user.ID,
+			"name":  user.Name,
+			"email": user.Email,
+		})
+	}
+
+	if err := d.Set("users", users); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Who is on call changes with every handoff, so the ID only identifies what was asked for
+	if scheduleID != "" {
+		d.SetId(teamID + ":" + scheduleID)
+	} else {
+		d.SetId(teamID)
+	}
+
+	return diag.Diagnostics{}
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceOnCallOverride() *schema.Resource {
+	return &schema.Resource{
+		Description:   "FireHydrant on-call overrides put someone else on call for part of an on-call schedule, such as while a member is on vacation.",
+		CreateContext: createResourceFireHydrantOnCallOverride,
+		UpdateContext: updateResourceFireHydrantOnCallOverride,
+		ReadContext:   readResourceFireHydrantOnCallOverride,
+		DeleteContext: deleteResourceFireHydrantOnCallOverride,
+		CustomizeDiff: customizeDiffFireHydrantOnCallOverride,
+		Importer: &schema.ResourceImporter{
+			StateContext: importOnCallOverride,
+		},
+		Schema: map[string]*schema.Schema{
+			"team_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"schedule_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The user who is on call instead during the override.",
+			},
+			"start_time": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339Diff,
+				Description:      "When the override starts, as an RFC3339 time such as \"2021-06-01T09:00:00Z\".",
+			},
+			"end_time": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339Diff,
+				Description:      "When the override ends, as an RFC3339 time. Must be after start_time.",
+			},
+		},
+	}
+}
+
+func readResourceFireHydrantOnCallOverride(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	r, err := ac.OnCallOverrides().Get(ctx, d.Get("team_id").(string), d.Get("schedule_id").(string), d.Id())
+	if firehydrant.IsNotFound(err) {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := convertOnCallOverrideToState(r, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func createResourceFireHydrantOnCallOverride(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	startTime, endTime, err := onCallOverrideTimesFromState(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	r := firehydrant.CreateOnCallOverrideRequest{
+		UserID:    d.Get("user_id").(string),
+		StartTime: startTime,
+		EndTime:   endTime,
+	}
+
+	scheduleID := d.Get("schedule_id").(string)
+	resource, err := ac.OnCallOverrides().Create(ctx, d.Get("team_id").(string), scheduleID, r)
+	if err != nil {
+		return diag.FromErr(onCallOverrideError(err, scheduleID))
+	}
+
+	d.SetId(resource.ID)
+
+	if err := convertOnCallOverrideToState(resource, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func updateResourceFireHydrantOnCallOverride(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	startTime, endTime, err := onCallOverrideTimesFromState(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	r := firehydrant.UpdateOnCallOverrideRequest{
+		UserID:    d.Get("user_id").(string),
+		StartTime: startTime,
+		EndTime:   endTime,
+	}
+
+	scheduleID := d.Get("schedule_id").(string)
+	_, err = ac.OnCallOverrides().Update(ctx, d.Get("team_id").(string), scheduleID, d.Id(), r)
+	if err != nil {
+		return diag.FromErr(onCallOverrideError(err, scheduleID))
+	}
+
+	return diag.Diagnostics{}
+}
+
+func deleteResourceFireHydrantOnCallOverride(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	// An override that is already gone, such as one removed along with its schedule, has nothing left to delete
+	err := ac.OnCallOverrides().Delete(ctx, d.Get("team_id").(string), d.Get("schedule_id").(string), d.Id())
+	if err != nil && !firehydrant.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return diag.Diagnostics{}
+}
+
+// customizeDiffFireHydrantOnCallOverride makes sure an override starts before it ends at plan time.
+// Times that are not known until apply are left for the next plan to check
+func customizeDiffFireHydrantOnCallOverride(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("start_time") || !d.NewValueKnown("end_time") {
+		return nil
+	}
+
+	startTime, err := time.Parse(time.RFC3339, d.Get("start_time").(string))
+	if err != nil {
+		return err
+	}
+
+	endTime, err := time.Parse(time.RFC3339, d.Get("end_time").(string))
+	if err != nil {
+		return err
+	}
+
+	if !startTime.Before(endTime) {
+		return fmt.Errorf("start_time (%s) must be before end_time (%s)", d.Get("start_time"), d.Get("end_time"))
+	}
+
+	return nil
+}
+
+// importOnCallOverride imports an override using an ID formatted as team_id:schedule_id:id
+func importOnCallOverride(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected team_id:schedule_id:id", d.Id())
+	}
+
+	if err := d.Set("team_id", parts[0]); err != nil {
+		return nil, err
+	}
+	if err := d.Set("schedule_id", parts[1]); err != nil {
+		return nil, err
+	}
+	d.SetId(parts[2])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// onCallOverrideError points out when an override was refused for overlapping another one, keeping
+// FireHydrant's explanation of the conflict
+func onCallOverrideError(err error, scheduleID string) error {
+	if firehydrant.IsConflict(err) {
+		return fmt.Errorf("override overlaps another override on schedule %s: %w", scheduleID, err)
+	}
+
+	return err
+}
+
+func onCallOverrideTimesFromState(d *schema.ResourceData) (time.Time, time.Time, error) {
+	startTime, err := time.Parse(time.RFC3339, d.Get("start_time").(string))
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	endTime, err := time.Parse(time.RFC3339, d.Get("end_time").(string))
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	return startTime, endTime, nil
+}
+
+func convertOnCallOverrideToState(override *firehydrant.OnCallOverrideResponse, d *schema.ResourceData) error {
+	attributes := map[string]interface{}{
+		"user_id":    override.UserID,
+		"start_time": override.StartTime.Format(time.RFC3339),
+		"end_time":   override.EndTime.Format(time.RFC3339),
+	}
+
+	return setAttributesFromMap(d, attributes)
+}
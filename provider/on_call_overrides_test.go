@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// Users can't be created through the API, so these tests need the ID of an existing one
+func testOnCallUserIsSetup(t *testing.T) {
+	testFireHydrantIsSetup(t)
+
+	if v := os.Getenv("FIREHYDRANT_USER_ID"); v == "" {
+		t.Skip("FIREHYDRANT_USER_ID must be set to test on-call overrides")
+	}
+}
+
+func TestAccOnCallOverrides(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	startTime := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Hour)
+	endTime := startTime.Add(8 * time.Hour)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testOnCallUserIsSetup(t) },
+		ProviderFactories: defaultProviderFactories(),
+		CheckDestroy:      testOnCallOverrideDoesNotExist("firehydrant_on_call_override.terraform-acceptance-test-override"),
+		Steps: []resource.TestStep{
+			{
+				Config: testOnCallOverrideConfig(rName, startTime, endTime),
+				Check: resource.ComposeTestCheckFunc(
+					testOnCallOverrideExists("firehydrant_on_call_override.terraform-acceptance-test-override"),
+					resource.TestCheckResourceAttr("firehydrant_on_call_override.terraform-acceptance-test-override", "user_id", os.Getenv("FIREHYDRANT_USER_ID")),
+					resource.TestCheckResourceAttr("firehydrant_on_call_override.terraform-acceptance-test-override", "start_time", startTime.Format(time.RFC3339)),
+				),
+			},
+			{
+				Config: testOnCallOverrideConfig(rName, startTime, endTime.Add(4*time.Hour)),
+				Check: resource.ComposeTestCheckFunc(
+					testOnCallOverrideExists("firehydrant_on_call_override.terraform-acceptance-test-override"),
+					resource.TestCheckResourceAttr("firehydrant_on_call_override.terraform-acceptance-test-override", "end_time", endTime.Add(4*time.Hour).Format(time.RFC3339)),
+				),
+			},
+		},
+	})
+}
+
+func TestCreateOnCallOverrideConflict(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"detail": "Override overlaps an existing override"}`))
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceOnCallOverride().Schema, map[string]interface{}{
+		"team_id":     "test-team-id",
+		"schedule_id": "test-schedule-id",
+		"user_id":     "test-user-id",
+		"start_time":  "2021-06-01T09:00:00Z",
+		"end_time":    "2021-06-01T17:00:00Z",
+	})
+
+	diags := createResourceFireHydrantOnCallOverride(context.TODO(), d, ac)
+	if !diags.HasError() {
+		t.Fatalf("Expected an error creating an overlapping override")
+	}
+
+	summary := diags[0].Summary
+	for _, expected := range []string{"overlaps another override on schedule test-schedule-id", "Override overlaps an existing override"} {
+		if !strings.Contains(summary, expected) {
+			t.Errorf("Expected the error to contain %q, Got: %s", expected, summary)
+		}
+	}
+}
+
+const testOnCallOverrideConfigTemplate = `
+resource "firehydrant_team" "team" {
+	name = "%s"
+}
+
+resource "firehydrant_schedule" "schedule" {
+	team_id    = firehydrant_team.team.id
+	name       = "%s"
+	time_zone  = "America/New_York"
+	member_ids = ["%s"]
+
+	strategy {
+		type         = "weekly"
+		handoff_time = "09:00:00"
+		handoff_day  = "monday"
+	}
+}
+
+resource "firehydrant_on_call_override" "terraform-acceptance-test-override" {
+	team_id     = firehydrant_team.team.id
+	schedule_id = firehydrant_schedule.schedule.id
+	user_id     = "%s"
+	start_time  = "%s"
+	end_time    = "%s"
+}
+`
+
+func testOnCallOverrideConfig(rName string, startTime, endTime time.Time) string {
+	userID := os.Getenv("FIREHYDRANT_USER_ID")
+	return fmt.Sprintf(testOnCallOverrideConfigTemplate, rName, rName, userID, userID, startTime.Format(time.RFC3339), endTime.Format(time.RFC3339))
+}
+
+func testOnCallOverrideExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		override, err := c.OnCallOverrides().Get(context.TODO(), rs.Primary.Attributes["team_id"], rs.Primary.Attributes["schedule_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if expected, got := rs.Primary.Attributes["user_id"], override.UserID; expected != got {
+			return fmt.Errorf("Unexpected user_id. Expected: %s, got: %s", expected, got)
+		}
+
+		return nil
+	}
+}
+
+func testOnCallOverrideDoesNotExist(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return nil
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		override, err := c.OnCallOverrides().Get(context.TODO(), rs.Primary.Attributes["team_id"], rs.Primary.Attributes["schedule_id"], rs.Primary.ID)
+		if override != nil {
+			return fmt.Errorf("The on-call override existed, when it should not")
+		}
+
+		if !firehydrant.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestOnCallDataSource(t *testing.T) {
+	var paths []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		paths = append(paths, req.URL.Path)
+		w.Write([]byte(`{"users": [{"id": "user-1", "name": "Jane Doe", "email": "jane@example.com"}]}`))
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	team := schema.TestResourceDataRaw(t, dataSourceOnCall().Schema, map[string]interface{}{
+		"team_id": "team-id",
+	})
+	if diags := dataFireHydrantOnCall(context.TODO(), team, ac); diags.HasError() {
+		t.Fatalf("Received error reading who is on call: %+v", diags)
+	}
+
+	schedule := schema.TestResourceDataRaw(t, dataSourceOnCall().Schema, map[string]interface{}{
+		"team_id":     "team-id",
+		"schedule_id": "schedule-id",
+	})
+	if diags := dataFireHydrantOnCall(context.TODO(), schedule, ac); diags.HasError() {
+		t.Fatalf("Received error reading who is on call: %+v", diags)
+	}
+
+	expectedPaths := []string{"/teams/team-id/on_call", "/teams/team-id/on_call_schedules/schedule-id/on_call"}
+	if !reflect.DeepEqual(expectedPaths, paths) {
+		t.Fatalf("Expected %v, Got: %v for the on-call requests", expectedPaths, paths)
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{"id": "user-1", "name": "Jane Doe", "email": "jane@example.com"},
+	}
+	if got := schedule.Get("users"); !reflect.DeepEqual(expected, got) {
+		t.Fatalf("Expected %+v, Got: %+v for users", expected, got)
+	}
+
+	if schedule.Id() != "team-id:schedule-id" {
+		t.Fatalf("Expected the team and schedule to be used as the ID, Got: %s", schedule.Id())
+	}
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPriorities(t *testing.T) {
+	rSlug := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testFireHydrantIsSetup(t) },
+		ProviderFactories: defaultProviderFactories(),
+		CheckDestroy:      testPriorityDoesNotExist("firehydrant_priority.terraform-acceptance-test-priority"),
+		Steps: []resource.TestStep{
+			{
+				Config: testPriorityConfig(rSlug, "first description"),
+				Check: resource.ComposeTestCheckFunc(
+					testPriorityExists("firehydrant_priority.terraform-acceptance-test-priority"),
+					resource.TestCheckResourceAttr("firehydrant_priority.terraform-acceptance-test-priority", "slug", rSlug),
+					resource.TestCheckResourceAttr("firehydrant_priority.terraform-acceptance-test-priority", "description", "first description"),
+					resource.TestCheckResourceAttr("firehydrant_priority.terraform-acceptance-test-priority", "default", "false"),
+					resource.TestCheckResourceAttrPair("data.firehydrant_priority.by_slug", "description", "firehydrant_priority.terraform-acceptance-test-priority", "description"),
+				),
+			},
+			{
+				Config: testPriorityConfig(rSlug, "updated description"),
+				Check: resource.ComposeTestCheckFunc(
+					testPriorityExists("firehydrant_priority.terraform-acceptance-test-priority"),
+					resource.TestCheckResourceAttr("firehydrant_priority.terraform-acceptance-test-priority", "description", "updated description"),
+				),
+			},
+		},
+	})
+}
+
+const testPriorityConfigTemplate = `
+resource "firehydrant_priority" "terraform-acceptance-test-priority" {
+	slug        = "%s"
+	description = "%s"
+}
+
+data "firehydrant_priority" "by_slug" {
+	slug = firehydrant_priority.terraform-acceptance-test-priority.slug
+}
+`
+
+func testPriorityConfig(rSlug, description string) string {
+	return fmt.Sprintf(testPriorityConfigTemplate, rSlug, description)
+}
+
+func testPriorityExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		priority, err := c.Priorities().Get(context.TODO(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if expected, got := rs.Primary.Attributes["description"], priority.Description; expected != got {
+			return fmt.Errorf("Expected description %s, got %s", expected, got)
+		}
+
+		return nil
+	}
+}
+
+func testPriorityDoesNotExist(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return nil
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		priority, err := c.Priorities().Get(context.TODO(), rs.Primary.ID)
+		if priority != nil {
+			return fmt.Errorf("The priority existed, when it should not")
+		}
+
+		if !firehydrant.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}
+}
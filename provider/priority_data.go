@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourcePriority() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataFireHydrantPriority,
+		Schema: map[string]*schema.Schema{
+			"slug": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataFireHydrantPriority(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	slug := d.Get("slug").(string)
+
+	r, err := ac.Priorities().Get(ctx, slug)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := convertPriorityToState(r, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(r.Slug)
+
+	return diag.Diagnostics{}
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePriority() *schema.Resource {
+	return &schema.Resource{
+		Description:   "FireHydrant priorities describe how urgently an incident needs to be worked on, such as P1 through P4.",
+		CreateContext: createResourceFireHydrantPriority,
+		UpdateContext: updateResourceFireHydrantPriority,
+		ReadContext:   readResourceFireHydrantPriority,
+		DeleteContext: deleteResourceFireHydrantPriority,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"slug": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSeveritySlug,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"default": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func readResourceFireHydrantPriority(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	r, err := ac.Priorities().Get(ctx, d.Id())
+	if firehydrant.IsNotFound(err) {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := convertPriorityToState(r, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func createResourceFireHydrantPriority(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r := firehydrant.CreatePriorityRequest{
+		Slug:        d.Get("slug").(string),
+		Description: d.Get("description").(string),
+		Default:     d.Get("default").(bool),
+	}
+
+	resource, err := ac.Priorities().Create(ctx, r)
+	if err != nil {
+		return priorityDiagnostics(err, r.Default)
+	}
+
+	d.SetId(resource.Slug)
+
+	if err := convertPriorityToState(resource, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func updateResourceFireHydrantPriority(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r := firehydrant.UpdatePriorityRequest{
+		Description: d.Get("description").(string),
+		Default:     d.Get("default").(bool),
+	}
+
+	_, err := ac.Priorities().Update(ctx, d.Id(), r)
+	if err != nil {
+		return priorityDiagnostics(err, r.Default)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func deleteResourceFireHydrantPriority(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	err := ac.Priorities().Delete(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return diag.Diagnostics{}
+}
+
+func convertPriorityToState(priority *firehydrant.PriorityResponse, d *schema.ResourceData) error {
+	attributes := map[string]interface{}{
+		"slug":        priority.Slug,
+		"description": priority.Description,
+		"default":     priority.Default,
+	}
+
+	return setAttributesFromMap(d, attributes)
+}
+
+// priorityDiagnostics explains FireHydrant rejecting a second default priority, which
+// otherwise only shows up as a validation failure with little context
+func priorityDiagnostics(err error, isDefault bool) diag.Diagnostics {
+	var apiErr *firehydrant.APIError
+	if !isDefault || !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnprocessableEntity {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  "Only one priority can be the default",
+			Detail:   fmt.Sprintf("FireHydrant would not make this priority the default, most likely because another priority already is. Set default to false on the other priority first.\n\n%s", apiErr),
+		},
+	}
+}
@@ -2,37 +2,48 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
-	apiKeyName             = "api_key"
-	firehydrantBaseURLName = "firehydrant_base_url"
+	apiKeyName                 = "api_key"
+	firehydrantBaseURLName     = "firehydrant_base_url"
+	maxRetriesName             = "max_retries"
+	retryBaseDelayName         = "retry_base_delay"
+	protectManagedServicesName = "protect_managed_services"
+	caCertFileName             = "ca_cert_file"
+	allowedLabelsName          = "allowed_labels"
+	requestCacheTTLName        = "request_cache_ttl"
+	defaultLabelsName          = "default_labels"
+	httpTimeoutName            = "http_timeout"
+	validateReferencesName     = "validate_references"
+	expectedActorTypeName      = "expected_actor_type"
+	maxConcurrentRequestsName  = "max_concurrent_requests"
 )
 
-const (
-	// MajorVersion is the major version
-	MajorVersion = 0
-	// MinorVersion is the minor version
-	MinorVersion = 1
-	// PatchVersion is the patch version
-	PatchVersion = 0
-
-	// UserAgentPrefix is the prefix of the User-Agent header that all terraform REST calls perform
-	UserAgentPrefix = "firehydrant-terraform-provider"
-)
+// defaultHTTPTimeout bounds requests to FireHydrant when the provider's http_timeout is not set
+const defaultHTTPTimeout = time.Minute
 
-// Version is the semver of this provider
-var Version = fmt.Sprintf("%d.%d.%d", MajorVersion, MinorVersion, PatchVersion)
+// slowResourceTimeout is how long resources that FireHydrant is slow to change, such as workflows,
+// wait on a create, update, or delete unless their timeouts block says otherwise
+const slowResourceTimeout = 10 * time.Minute
 
 // Provider returns a terraform provider for the FireHydrant API
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			apiKeyName: {
 				Type:        schema.TypeString,
@@ -41,46 +52,293 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("FIREHYDRANT_API_KEY", nil),
 			},
 			firehydrantBaseURLName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("FIREHYDRANT_BASE_URL", firehydrant.DefaultBaseURL),
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "The URL of the FireHydrant API, such as a staging tenant's. If not set, the environment variable `FIREHYDRANT_BASE_URL` is used, and then https://api.firehydrant.io/v1/.",
+			},
+			maxRetriesName: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      firehydrant.DefaultMaxRetries,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "How many times a rate limited or failed request to FireHydrant is retried.",
+			},
+			retryBaseDelayName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      firehydrant.DefaultRetryBaseDelay.String(),
+				ValidateFunc: validateDuration,
+				Description:  "The delay before the first retry, such as \"500ms\" or \"2s\". Each retry after it waits twice as long.",
+			},
+			maxConcurrentRequestsName: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "How many requests to FireHydrant may be in flight at once, no matter Terraform's `-parallelism`. Requests over the limit wait their turn instead of failing. Set to 0 for no limit.",
+			},
+			requestCacheTTLName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "0s",
+				ValidateFunc: validateDuration,
+				Description:  "How long to reuse FireHydrant's answer to a lookup, such as \"30s\", instead of asking again. Creating, updating, or deleting a resource clears the answers cached for that kind of resource. Lookups are not cached by default.",
+			},
+			httpTimeoutName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      defaultHTTPTimeout.String(),
+				ValidateFunc: validateDuration,
+				Description:  "How long to wait on a request to FireHydrant, including its retries, before giving up, such as \"30s\". Resources that FireHydrant is slow to change, such as workflows and schedules, wait as long as their `timeouts` block allows instead. Set to \"0s\" to never give up.",
+			},
+			protectManagedServicesName: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Refuse to change services that are managed by an integration other than Terraform, such as PagerDuty.",
+			},
+			validateReferencesName: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Check that the services a functionality lists exist while planning, so that a mistyped ID fails the plan instead of the apply. Each newly listed service takes one extra request.",
+			},
+			expectedActorTypeName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("FIREHYDRANT_EXPECTED_ACTOR_TYPE", nil),
+				ValidateFunc: validation.StringInSlice([]string{"user", "bot"}, false),
+				Description:  "Fail unless the API key belongs to this kind of actor, user or bot, such as to make sure CI uses a bot's key. If not set, the environment variable `FIREHYDRANT_EXPECTED_ACTOR_TYPE` is used.",
+			},
+			caCertFileName: {
 				Type:        schema.TypeString,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("FIREHYDRANT_BASE_URL", "https://api.firehydrant.io/v1/"),
+				DefaultFunc: schema.EnvDefaultFunc("FIREHYDRANT_CA_CERT_FILE", ""),
+				Description: "A file of PEM encoded certificates to trust along with the system's, such as a proxy's internal certificate authority. If not set, the environment variable `FIREHYDRANT_CA_CERT_FILE` is used. Proxies are always read from `HTTPS_PROXY` and the other standard proxy environment variables.",
+			},
+			defaultLabelsName: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Labels added to every service, such as a cost center. A service's own labels win when both set the same key.",
+			},
+			allowedLabelsName: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The label keys services may use, and optionally the values allowed for each. When set, plans with service labels that are not listed fail.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"values": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The values allowed for this key. Any value is allowed when this is empty.",
+						},
+					},
+				},
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"firehydrant_service":       resourceService(),
-			"firehydrant_environment":   resourceEnvironment(),
-			"firehydrant_functionality": resourceFunctionality(),
-			"firehydrant_team":          resourceTeam(),
-			"firehydrant_severity":      resourceSeverity(),
-			"firehydrant_runbook":       resourceRunbook(),
+			"firehydrant_service":                resourceService(),
+			"firehydrant_environment":            resourceEnvironment(),
+			"firehydrant_functionality":          resourceFunctionality(),
+			"firehydrant_team":                   resourceTeam(),
+			"firehydrant_severity":               resourceSeverity(),
+			"firehydrant_runbook":                resourceRunbook(),
+			"firehydrant_schedule":               resourceSchedule(),
+			"firehydrant_escalation_policy":      resourceEscalationPolicy(),
+			"firehydrant_service_dependency":     resourceServiceDependency(),
+			"firehydrant_incident_role":          resourceIncidentRole(),
+			"firehydrant_priority":               resourcePriority(),
+			"firehydrant_signal_rule":            resourceSignalRule(),
+			"firehydrant_task_list":              resourceTaskList(),
+			"firehydrant_webhook":                resourceWebhook(),
+			"firehydrant_service_link":           resourceServiceLink(),
+			"firehydrant_incident_type":          resourceIncidentType(),
+			"firehydrant_status_page_component":  resourceStatusPageComponent(),
+			"firehydrant_change_event":           resourceChangeEvent(),
+			"firehydrant_workflow":               resourceWorkflow(),
+			"firehydrant_service_subscription":   resourceServiceSubscription(),
+			"firehydrant_scheduled_maintenance":  resourceScheduledMaintenance(),
+			"firehydrant_on_call_override":       resourceOnCallOverride(),
+			"firehydrant_retrospective_template": resourceRetrospectiveTemplate(),
+			"firehydrant_service_alert_grouping": resourceServiceAlertGrouping(),
+			"firehydrant_service_environment":    resourceServiceEnvironment(),
+			"firehydrant_signals_email_target":   resourceSignalsEmailTarget(),
+			"firehydrant_status_page_layout":     resourceStatusPageLayout(),
+			"firehydrant_runbook_attachment":     resourceRunbookAttachment(),
+			"firehydrant_saved_search":           resourceSavedSearch(),
+			"firehydrant_ticketing_integration":  resourceTicketingIntegration(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"firehydrant_service":        dataSourceService(),
-			"firehydrant_services":       dataSourceServices(),
-			"firehydrant_environment":    dataSourceEnvironment(),
-			"firehydrant_functionality":  dataSourceFunctionality(),
-			"firehydrant_runbook":        dataSourceRunbook(),
-			"firehydrant_runbook_action": dataSourceRunbookAction(),
+			"firehydrant_service":                 dataSourceService(),
+			"firehydrant_services":                dataSourceServices(),
+			"firehydrant_environment":             dataSourceEnvironment(),
+			"firehydrant_functionality":           dataSourceFunctionality(),
+			"firehydrant_runbook":                 dataSourceRunbook(),
+			"firehydrant_runbook_action":          dataSourceRunbookAction(),
+			"firehydrant_incident_role":           dataSourceIncidentRole(),
+			"firehydrant_priority":                dataSourcePriority(),
+			"firehydrant_team":                    dataSourceTeam(),
+			"firehydrant_user":                    dataSourceUser(),
+			"firehydrant_incident_type":           dataSourceIncidentType(),
+			"firehydrant_severities":              dataSourceSeverities(),
+			"firehydrant_runbook_actions":         dataSourceRunbookActions(),
+			"firehydrant_integration_connections": dataSourceIntegrationConnections(),
+			"firehydrant_current_user":            dataSourceCurrentUser(),
+			"firehydrant_incidents":               dataSourceIncidents(),
+			"firehydrant_on_call":                 dataSourceOnCall(),
+			"firehydrant_severity":                dataSourceSeverity(),
 		},
-		ConfigureContextFunc: setupFireHydrantContext,
 	}
+
+	// Terraform only sets its version on the provider once it starts configuring it
+	p.ConfigureContextFunc = func(ctx context.Context, rd *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		return setupFireHydrantContext(ctx, rd, p.TerraformVersion)
+	}
+
+	return p
 }
 
-func setupFireHydrantContext(ctx context.Context, rd *schema.ResourceData) (interface{}, diag.Diagnostics) {
+func setupFireHydrantContext(ctx context.Context, rd *schema.ResourceData, terraformVersion string) (interface{}, diag.Diagnostics) {
 	apiKey := rd.Get(apiKeyName).(string)
 	fireHydrantBaseURL := rd.Get(firehydrantBaseURLName).(string)
 
-	ac, err := firehydrant.NewRestClient(apiKey, firehydrant.WithBaseURL(fireHydrantBaseURL))
+	retryBaseDelay, err := time.ParseDuration(rd.Get(retryBaseDelayName).(string))
+	if err != nil {
+		return nil, diag.FromErr(fmt.Errorf("could not parse %s: %w", retryBaseDelayName, err))
+	}
+
+	requestCacheTTL, err := time.ParseDuration(rd.Get(requestCacheTTLName).(string))
+	if err != nil {
+		return nil, diag.FromErr(fmt.Errorf("could not parse %s: %w", requestCacheTTLName, err))
+	}
+
+	httpTimeout, err := time.ParseDuration(rd.Get(httpTimeoutName).(string))
+	if err != nil {
+		return nil, diag.FromErr(fmt.Errorf("could not parse %s: %w", httpTimeoutName, err))
+	}
+
+	opts := []firehydrant.OptFunc{
+		firehydrant.WithBaseURL(fireHydrantBaseURL),
+		firehydrant.WithRetries(rd.Get(maxRetriesName).(int), retryBaseDelay),
+		firehydrant.WithUserAgent(userAgent(terraformVersion)),
+		firehydrant.WithRateLimitObserver(logRateLimit),
+		firehydrant.WithResponseCache(requestCacheTTL),
+		firehydrant.WithRequestTimeout(httpTimeout),
+		firehydrant.WithMaxConcurrentRequests(rd.Get(maxConcurrentRequestsName).(int)),
+	}
+	// Bodies are only read for logging when the log would show them
+	if logging.IsDebugOrHigher() {
+		opts = append(opts, firehydrant.WithRequestLogger(logRequest))
+	}
+	if caCertFile := rd.Get(caCertFileName).(string); caCertFile != "" {
+		opts = append(opts, firehydrant.WithCACertFile(caCertFile))
+	}
+
+	ac, err := firehydrant.NewRestClient(apiKey, opts...)
 	if err != nil {
 		return nil, diag.FromErr(fmt.Errorf("could not initialize API client: %w", err))
 	}
 
-	_, err = ac.Ping(ctx)
+	ping, err := ac.Ping(ctx)
 	if err != nil {
-		return nil, diag.FromErr(err)
+		return nil, diag.FromErr(apiKeyError(err))
+	}
+
+	log.Printf("[DEBUG] Authenticated with FireHydrant as %s %q (%s)", ping.Actor.Type, ping.Actor.Name, ping.Actor.ID)
+	diags := checkActorType(ping.Actor, rd.Get(expectedActorTypeName).(string))
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &providerMeta{
+		Client:                 ac,
+		protectManagedServices: rd.Get(protectManagedServicesName).(bool),
+		validateReferences:     rd.Get(validateReferencesName).(bool),
+		allowedLabels:          allowedLabelsFromConfig(rd.Get(allowedLabelsName).([]interface{})),
+		defaultLabels:          convertStringMap(rd.Get(defaultLabelsName).(map[string]interface{})),
+	}, diags
+}
+
+// checkActorType makes sure the API key belongs to the expected kind of actor. Without an expectation,
+// it warns when a user's personal key is used where Terraform is running in automation, since FireHydrant
+// attributes changes to that user and some endpoints behave differently for bots
+func checkActorType(actor firehydrant.Actor, expected string) diag.Diagnostics {
+	if expected != "" && actor.Type != expected {
+		return diag.Errorf("the API key belongs to the %s %q, but %s is %s", actor.Type, actor.Name, expectedActorTypeName, expected)
+	}
+
+	if expected == "" && actor.Type == "user" && os.Getenv("TF_IN_AUTOMATION") != "" {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Using a personal FireHydrant API key in automation",
+			Detail:   fmt.Sprintf("The API key belongs to the user %q, but TF_IN_AUTOMATION is set. Use a bot's API key, or set %s to user to keep using this one.", actor.Name, expectedActorTypeName),
+		}}
+	}
+
+	return diag.Diagnostics{}
+}
+
+// logRateLimit shows how close an apply is to FireHydrant's rate limit when running with TF_LOG=DEBUG,
+// which helps with picking how many resources Terraform changes in parallel
+func logRateLimit(remaining, limit int) {
+	log.Printf("[DEBUG] FireHydrant rate limit: %d of %d requests remaining", remaining, limit)
+}
+
+// logRequest shows every request to FireHydrant and its response when running with TF_LOG=DEBUG, with
+// the API key and secret fields left out
+func logRequest(message string) {
+	log.Printf("[DEBUG] %s", message)
+}
+
+// providerMeta is handed to every resource and data source. It embeds the API client so that
+// they can keep using it as a firehydrant.Client, and carries the provider settings they need
+type providerMeta struct {
+	firehydrant.Client
+
+	protectManagedServices bool
+
+	// validateReferences checks that referenced objects exist while planning
+	validateReferences bool
+
+	// allowedLabels maps each label key services may use to its allowed values, where no values
+	// allows any value. It is nil when every label is allowed
+	allowedLabels map[string][]string
+
+	// defaultLabels are added to every service, under the service's own labels
+	defaultLabels map[string]string
+}
+
+// defaultLabelsFromMeta returns the provider's default_labels, which are empty when the provider
+// was not configured with them
+func defaultLabelsFromMeta(m interface{}) map[string]string {
+	if meta, ok := m.(*providerMeta); ok {
+		return meta.defaultLabels
+	}
+
+	return nil
+}
+
+func allowedLabelsFromConfig(config []interface{}) map[string][]string {
+	if len(config) == 0 {
+		return nil
 	}
 
-	return ac, nil
+	allowed := map[string][]string{}
+	for _, v := range config {
+		label := v.(map[string]interface{})
+		key := label["key"].(string)
+		allowed[key] = append(allowed[key], convertStringSet(label["values"].(*schema.Set))...)
+	}
+
+	return allowed
 }
 
 func convertStringMap(sm map[string]interface{}) map[string]string {
@@ -92,6 +350,15 @@ func convertStringMap(sm map[string]interface{}) map[string]string {
 	return m
 }
 
+func convertStringSet(set *schema.Set) []string {
+	s := []string{}
+	for _, v := range set.List() {
+		s = append(s, v.(string))
+	}
+
+	return s
+}
+
 func setAttributesFromMap(d *schema.ResourceData, sm map[string]interface{}) error {
 	for k, v := range sm {
 		if err := d.Set(k, v); err != nil {
@@ -101,3 +368,139 @@ func setAttributesFromMap(d *schema.ResourceData, sm map[string]interface{}) err
 
 	return nil
 }
+
+// importParentID imports settings that an object has at most one of, such as a service's Slack channel,
+// using the object's ID as their own. The object's ID is stored in the parentKey attribute
+func importParentID(parentKey string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		if err := d.Set(parentKey, d.Id()); err != nil {
+			return nil, err
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// importScopedResource imports resources that live under another object, such as a team, using an ID
+// formatted as parent_id:id. The parent's ID is stored in the parentKey attribute
+func importScopedResource(parentKey string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		parts := strings.SplitN(d.Id(), ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("unexpected import ID %q, expected %s:id", d.Id(), parentKey)
+		}
+
+		if err := d.Set(parentKey, parts[0]); err != nil {
+			return nil, err
+		}
+		d.SetId(parts[1])
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// validateDuration ensures a value can be parsed by time.ParseDuration
+func validateDuration(v interface{}, k string) ([]string, []error) {
+	value, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if _, err := time.ParseDuration(value); err != nil {
+		return nil, []error{fmt.Errorf("%s must be a duration such as \"500ms\" or \"2s\", got %q", k, value)}
+	}
+
+	return nil, nil
+}
+
+// withResourceTimeout lets the requests made for an operation run as long as the resource's timeouts
+// block allows, instead of the provider's http_timeout
+func withResourceTimeout(ctx context.Context, d *schema.ResourceData, key string) context.Context {
+	return firehydrant.WithOperationTimeout(ctx, d.Timeout(key))
+}
+
+// suppressMarkdownWhitespaceDiff ignores changes FireHydrant makes to the whitespace of markdown it
+// stores, such as dropping trailing spaces or turning CRLF line endings into LF, so that a multi-line
+// description in a heredoc does not show a diff on every plan
+func suppressMarkdownWhitespaceDiff(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeMarkdownWhitespace(old) == normalizeMarkdownWhitespace(new)
+}
+
+func normalizeMarkdownWhitespace(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = strings.ReplaceAll(s, "\r", "\n")
+
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n")
+}
+
+// slugPattern matches the slugs FireHydrant accepts for things such as services and teams
+var slugPattern = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
+// severitySlugPattern matches severity and priority slugs, which FireHydrant also accepts in uppercase
+// since they are conventionally written that way, such as SEV1 and P1
+var severitySlugPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// validateSlug catches slugs FireHydrant would reject, such as ones with spaces or uppercase letters,
+// before anything is applied
+func validateSlug(v interface{}, k string) ([]string, []error) {
+	value, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if !slugPattern.MatchString(value) {
+		return nil, []error{fmt.Errorf("%s may only contain lowercase letters, numbers, hyphens, and underscores, such as \"customer-impact\", got %q", k, value)}
+	}
+
+	return nil, nil
+}
+
+// validateSeveritySlug is validateSlug for the slugs of severities and priorities
+func validateSeveritySlug(v interface{}, k string) ([]string, []error) {
+	value, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if !severitySlugPattern.MatchString(value) {
+		return nil, []error{fmt.Errorf("%s may only contain letters, numbers, hyphens, and underscores, such as \"SEV1\", got %q", k, value)}
+	}
+
+	return nil, nil
+}
+
+// slugTakenError points out when something, such as a service, could not be created because its slug
+// is taken, since slugs are unique across a FireHydrant organization
+func slugTakenError(err error, kind string, slug string) error {
+	var apiErr *firehydrant.APIError
+	if slug == "" || !errors.As(err, &apiErr) {
+		return err
+	}
+
+	taken := firehydrant.IsConflict(err)
+	for _, fieldErr := range apiErr.FieldErrors {
+		if fieldErr.Field == "slug" {
+			taken = true
+		}
+	}
+
+	if taken {
+		return fmt.Errorf("slug %q could not be used, check that no other %s already has it: %w", slug, kind, err)
+	}
+
+	return err
+}
+
+// userAgent identifies the provider version, and the Terraform version when it is known, to FireHydrant
+func userAgent(terraformVersion string) string {
+	if terraformVersion == "" {
+		return firehydrant.DefaultUserAgent()
+	}
+
+	return fmt.Sprintf("%s Terraform/%s", firehydrant.DefaultUserAgent(), terraformVersion)
+}
@@ -2,12 +2,22 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -52,12 +62,924 @@ func TestAccService(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.firehydrant_services.services", "services.0.name", rNameUpdated),
 					resource.TestCheckResourceAttr("data.firehydrant_services.services", "services.0.service_tier", "5"),
+					resource.TestCheckResourceAttrSet("data.firehydrant_services.services", "services.0.slug"),
+					resource.TestCheckResourceAttr("data.firehydrant_services.services", "services.0.labels.key1", "value1"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccServiceTier(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testFireHydrantIsSetup(t) },
+		ProviderFactories: defaultProviderFactories(),
+		CheckDestroy:      testServiceDoesNotExist("firehydrant_service.terraform-acceptance-test-service"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testServiceTierConfig(rName, "service_tier = 9"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expected service_tier to be in the range \(0 - 5\), got 9`),
+			},
+			{
+				Config: testServiceTierConfig(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testServiceExists("firehydrant_service.terraform-acceptance-test-service"),
+					resource.TestCheckResourceAttr("firehydrant_service.terraform-acceptance-test-service", "service_tier", "5"),
+				),
+			},
+			{
+				Config: testServiceTierConfig(rName, "service_tier = 0"),
+				Check: resource.ComposeTestCheckFunc(
+					testServiceExists("firehydrant_service.terraform-acceptance-test-service"),
+					resource.TestCheckResourceAttr("firehydrant_service.terraform-acceptance-test-service", "service_tier", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testServiceTierConfigTemplate = `
+resource "firehydrant_service" "terraform-acceptance-test-service" {
+	name = "%s"
+	%s
+}
+`
+
+func testServiceTierConfig(rName, serviceTier string) string {
+	return fmt.Sprintf(testServiceTierConfigTemplate, rName, serviceTier)
+}
+
+func TestAccServiceOwner(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testFireHydrantIsSetup(t) },
+		ProviderFactories: defaultProviderFactories(),
+		CheckDestroy:      testServiceDoesNotExist("firehydrant_service.terraform-acceptance-test-service"),
+		Steps: []resource.TestStep{
+			{
+				Config: testServiceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testServiceExists("firehydrant_service.terraform-acceptance-test-service"),
+					resource.TestCheckResourceAttr("firehydrant_service.terraform-acceptance-test-service", "owner_id", ""),
+				),
+			},
+			{
+				Config: testServiceWithOwnerConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testServiceExists("firehydrant_service.terraform-acceptance-test-service"),
+					resource.TestCheckResourceAttrPair("firehydrant_service.terraform-acceptance-test-service", "owner_id", "firehydrant_team.owner", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestFindCreatedService(t *testing.T) {
+	sentAt := time.Now()
+	services := firehydrant.ServicesResponse{
+		Services: []firehydrant.ServiceResponse{
+			{ID: "older", Name: "Chow Hall", CreatedAt: sentAt.Add(-time.Hour)},
+			{ID: "other-name", Name: "Chow Hall 2", CreatedAt: sentAt},
+			{ID: "orphan", Name: "Chow Hall", CreatedAt: sentAt.Add(time.Second)},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if err := json.NewEncoder(w).Encode(services); err != nil {
+			panic(err)
+		}
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	found := findCreatedService(context.TODO(), ac, "Chow Hall", sentAt, errors.New("context deadline exceeded"))
+	if found == nil || found.ID != "orphan" {
+		t.Fatalf("Expected the service created after the request was sent, Got: %+v", found)
+	}
+
+	// FireHydrant responding means the create definitely failed
+	if found := findCreatedService(context.TODO(), ac, "Chow Hall", sentAt, &firehydrant.APIError{StatusCode: 422}); found != nil {
+		t.Fatalf("Expected no service after FireHydrant rejected the create, Got: %+v", found)
+	}
+}
+
+func TestManagedExternalResources(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceService().Schema, map[string]interface{}{
+		"name": "service",
+		"external_resources": []interface{}{
+			map[string]interface{}{"connection_type": "pager_duty", "remote_id": "PABC123"},
+		},
+	})
+
+	resources := []firehydrant.ExternalResource{
+		{ConnectionType: "pager_duty", RemoteID: "PABC123", RemoteURL: "https://example.pagerduty.com/service-directory/PABC123"},
+		{ConnectionType: "opsgenie", RemoteID: "created-by-firehydrant"},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"connection_type": "pager_duty",
+			"connection_id":   "",
+			"remote_id":       "PABC123",
+			"remote_url":      "https://example.pagerduty.com/service-directory/PABC123",
+		},
+	}
+
+	if got := managedExternalResources(d, resources); !reflect.DeepEqual(expected, got) {
+		t.Fatalf("Expected %+v, Got: %+v for managed external resources", expected, got)
+	}
+}
+
+func TestServiceDeleteBehavior(t *testing.T) {
+	cases := map[string]string{
+		"":                    "/services/test-service-id",
+		deleteBehaviorArchive: "/services/test-service-id",
+		deleteBehaviorDestroy: "/services/test-service-id/destroy",
+	}
+
+	for behavior, expectedPath := range cases {
+		var requestPath, requestMethod string
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			requestPath, requestMethod = req.URL.Path, req.Method
+			w.WriteHeader(http.StatusNoContent)
+		}))
+
+		ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+		if err != nil {
+			t.Fatalf("Received error initializing API client: %s", err.Error())
+		}
+
+		raw := map[string]interface{}{"name": "service"}
+		if behavior != "" {
+			raw["delete_behavior"] = behavior
+		}
+		d := schema.TestResourceDataRaw(t, resourceService().Schema, raw)
+		d.SetId("test-service-id")
+
+		if diags := deleteResourceFireHydrantService(context.TODO(), d, ac); diags.HasError() {
+			t.Fatalf("Received error deleting service with delete_behavior %q: %+v", behavior, diags)
+		}
+		ts.Close()
+
+		if requestMethod != "DELETE" || requestPath != expectedPath {
+			t.Fatalf("Expected DELETE %s for delete_behavior %q, Got: %s %s", expectedPath, behavior, requestMethod, requestPath)
+		}
+	}
+}
+
+func TestEnvironmentDeleteBehavior(t *testing.T) {
+	cases := map[string]string{
+		"":                    "/environments/test-environment-id",
+		deleteBehaviorArchive: "/environments/test-environment-id",
+		deleteBehaviorDestroy: "/environments/test-environment-id/destroy",
+	}
+
+	for behavior, expectedPath := range cases {
+		var requestPath, requestMethod string
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			requestPath, requestMethod = req.URL.Path, req.Method
+			w.WriteHeader(http.StatusNoContent)
+		}))
+
+		ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+		if err != nil {
+			t.Fatalf("Received error initializing API client: %s", err.Error())
+		}
+
+		raw := map[string]interface{}{"name": "environment"}
+		if behavior != "" {
+			raw["delete_behavior"] = behavior
+		}
+		d := schema.TestResourceDataRaw(t, resourceEnvironment().Schema, raw)
+		d.SetId("test-environment-id")
+
+		if diags := deleteResourceFireHydrantEnvironment(context.TODO(), d, ac); diags.HasError() {
+			t.Fatalf("Received error deleting environment with delete_behavior %q: %+v", behavior, diags)
+		}
+		ts.Close()
+
+		if requestMethod != "DELETE" || requestPath != expectedPath {
+			t.Fatalf("Expected DELETE %s for delete_behavior %q, Got: %s %s", expectedPath, behavior, requestMethod, requestPath)
+		}
+	}
+}
+
+func TestImportedEnvironmentPlansNoChanges(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`{"id": "test-environment-id", "name": "production"}`))
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	r := resourceEnvironment()
+	d := r.TestResourceData()
+	d.SetId("test-environment-id")
+
+	if diags := readResourceFireHydrantEnvironment(context.TODO(), d, ac); diags.HasError() {
+		t.Fatalf("Received error reading the imported environment: %+v", diags)
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"name": "production"})
+	diff, err := r.Diff(context.TODO(), d.State(), config, ac)
+	if err != nil {
+		t.Fatalf("Received error planning the imported environment: %s", err.Error())
+	}
+	if !diff.Empty() {
+		t.Fatalf("Expected no changes after importing the environment, Got: %+v", diff.Attributes)
+	}
+}
+
+func TestEnvironmentDataSourceNameOnLaterPage(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("page") == "1" {
+			w.Write([]byte(`{"data": [{"id": "prod-eu-id", "name": "prod-eu"}], "pagination": {"page": 1, "pages": 2}}`))
+			return
+		}
+		w.Write([]byte(`{"data": [{"id": "prod-id", "name": "prod"}], "pagination": {"page": 2, "pages": 2}}`))
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceEnvironment().Schema, map[string]interface{}{"name": "prod"})
+	if diags := dataFireHydrantEnvironment(context.TODO(), d, ac); diags.HasError() {
+		t.Fatalf("Received error looking up the environment: %+v", diags)
+	}
+
+	if d.Id() != "prod-id" {
+		t.Fatalf("Expected the exact match from the second page, Got: %s", d.Id())
+	}
+}
+
+func TestUpdateServiceOnlySendsChanges(t *testing.T) {
+	var body map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method == "PATCH" {
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Errorf("Received error decoding the update: %s", err.Error())
+			}
+		}
+		w.Write([]byte(`{"id": "test-service-id", "name": "service", "description": "", "service_tier": 2, "labels": {"owner": "ui"}}`))
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	state := &terraform.InstanceState{
+		ID: "test-service-id",
+		Attributes: map[string]string{
+			"id":               "test-service-id",
+			"name":             "service",
+			"description":      "A service",
+			"service_tier":     "2",
+			"labels.%":         "1",
+			"labels.owner":     "ui",
+			"labels_all.%":     "1",
+			"labels_all.owner": "ui",
+			"delete_behavior":  deleteBehaviorArchive,
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":         "service",
+		"service_tier": 2,
+		"labels":       map[string]interface{}{"owner": "ui"},
+	})
+
+	r := resourceService()
+	diff, err := r.Diff(context.TODO(), state, config, ac)
+	if err != nil {
+		t.Fatalf("Received error planning the update: %s", err.Error())
+	}
+
+	if _, diags := r.Apply(context.TODO(), state, diff, ac); diags.HasError() {
+		t.Fatalf("Received error updating the service: %+v", diags)
+	}
+
+	// Clearing the description is sent, while the unchanged name, tier, and labels are left alone
+	expected := map[string]interface{}{"description": ""}
+	if !reflect.DeepEqual(expected, body) {
+		t.Fatalf("Expected %+v, Got: %+v for the update", expected, body)
+	}
+}
+
+func TestServiceCustomFields(t *testing.T) {
+	var bodies []map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(req.Body).Decode(&body); err == nil {
+			bodies = append(bodies, body)
+		}
+		w.Write([]byte(`{"id": "test-service-id", "name": "service", "service_tier": 5, "custom_fields": {"cost-center": "1234"}}`))
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	r := resourceService()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":          "service",
+		"custom_fields": map[string]interface{}{"cost-center": "1234"},
+	})
+
+	if diags := createResourceFireHydrantService(context.TODO(), d, ac); diags.HasError() {
+		t.Fatalf("Received error creating the service: %+v", diags)
+	}
+
+	expected := map[string]interface{}{"cost-center": "1234"}
+	if len(bodies) != 1 || !reflect.DeepEqual(expected, bodies[0]["custom_fields"]) {
+		t.Fatalf("Expected %+v, Got: %+v for the custom fields sent", expected, bodies)
+	}
+
+	// Removing every custom field clears them instead of leaving them unchanged
+	diff, err := r.Diff(context.TODO(), d.State(), terraform.NewResourceConfigRaw(map[string]interface{}{"name": "service"}), ac)
+	if err != nil {
+		t.Fatalf("Received error planning the update: %s", err.Error())
+	}
+	if _, diags := r.Apply(context.TODO(), d.State(), diff, ac); diags.HasError() {
+		t.Fatalf("Received error updating the service: %+v", diags)
+	}
+
+	expectedUpdate := map[string]interface{}{"custom_fields": map[string]interface{}{}}
+	if len(bodies) != 2 || !reflect.DeepEqual(expectedUpdate, bodies[1]) {
+		t.Fatalf("Expected %+v, Got: %+v for the update", expectedUpdate, bodies)
+	}
+}
+
+func TestUpdateServiceTeams(t *testing.T) {
+	var sent []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method == "PATCH" {
+			var body firehydrant.UpdateServiceRequest
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Errorf("Received error decoding the update: %s", err.Error())
+			}
+			for _, team := range *body.Teams {
+				sent = append(sent, team.ID)
+			}
+		}
+		w.Write([]byte(`{"id": "test-service-id", "name": "service", "service_tier": 5, "teams": [
+			{"id": "team-a", "name": "Team A"},
+			{"id": "team-b", "name": "Team B"},
+			{"id": "added-by-hand", "name": "Added by hand"}
+		]}`))
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	state := &terraform.InstanceState{
+		ID: "test-service-id",
+		Attributes: map[string]string{
+			"id":                 "test-service-id",
+			"name":               "service",
+			"service_tier":       "5",
+			"delete_behavior":    deleteBehaviorArchive,
+			"labels_all.%":       "0",
+			"active_incidents.#": "0",
+			"teams.#":            "2",
+		},
+	}
+	for _, id := range []string{"team-a", "team-b"} {
+		hash := hashServiceTeam(map[string]interface{}{"id": id})
+		state.Attributes[fmt.Sprintf("teams.%d.id", hash)] = id
+		state.Attributes[fmt.Sprintf("teams.%d.name", hash)] = ""
+	}
+
+	teamsConfig := func(ids ...string) *terraform.ResourceConfig {
+		teams := make([]interface{}, len(ids))
+		for index, id := range ids {
+			teams[index] = map[string]interface{}{"id": id}
+		}
+		return terraform.NewResourceConfigRaw(map[string]interface{}{"name": "service", "teams": teams})
+	}
+
+	r := resourceService()
+
+	// Reordering the teams is not a change
+	diff, err := r.Diff(context.TODO(), state, teamsConfig("team-b", "team-a"), ac)
+	if err != nil {
+		t.Fatalf("Received error planning the update: %s", err.Error())
+	}
+	if diff != nil && !diff.Empty() {
+		t.Fatalf("Expected no changes after reordering teams, Got: %+v", diff.Attributes)
+	}
+
+	diff, err = r.Diff(context.TODO(), state, teamsConfig("team-c", "team-b"), ac)
+	if err != nil {
+		t.Fatalf("Received error planning the update: %s", err.Error())
+	}
+	if _, diags := r.Apply(context.TODO(), state, diff, ac); diags.HasError() {
+		t.Fatalf("Received error updating the service: %+v", diags)
+	}
+
+	// team-a is detached, team-c is added, and the team added outside of Terraform is kept
+	sort.Strings(sent)
+	expected := []string{"added-by-hand", "team-b", "team-c"}
+	if !reflect.DeepEqual(expected, sent) {
+		t.Fatalf("Expected %v, Got: %v for the teams sent", expected, sent)
+	}
+}
+
+func TestCreateServiceSlugTaken(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"error": "Invalid request", "errors": [{"field": "slug", "message": "has already been taken"}]}`))
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceService().Schema, map[string]interface{}{
+		"name": "service",
+		"slug": "checkout-api",
+	})
+
+	diags := createResourceFireHydrantService(context.TODO(), d, ac)
+	if !diags.HasError() {
+		t.Fatalf("Expected an error creating a service with a taken slug")
+	}
+
+	for _, expected := range []string{`slug "checkout-api" could not be used`, "slug has already been taken"} {
+		if !strings.Contains(diags[0].Summary, expected) {
+			t.Errorf("Expected the error to contain %q, Got: %s", expected, diags[0].Summary)
+		}
+	}
+}
+
+func TestCreateServiceAdoptsExisting(t *testing.T) {
+	var requests []string
+	var body map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/services":
+			w.Write([]byte(`{"data": [{"id": "other-service-id", "name": "Chow Hall 2"}, {"id": "existing-service-id", "name": "Chow Hall", "slug": "chow-hall"}]}`))
+		case req.Method == http.MethodPatch:
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Errorf("Could not decode request: %s", err)
+			}
+			w.Write([]byte(`{"id": "existing-service-id", "name": "Chow Hall", "slug": "chow-hall", "service_tier": 2}`))
+		default:
+			w.Write([]byte(`{"id": "existing-service-id", "name": "Chow Hall", "slug": "chow-hall", "service_tier": 2}`))
+		}
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceService().Schema, map[string]interface{}{
+		"name":           "Chow Hall",
+		"service_tier":   2,
+		"adopt_existing": true,
+	})
+
+	diags := createResourceFireHydrantService(context.TODO(), d, ac)
+	if diags.HasError() {
+		t.Fatalf("Received error adopting service: %+v", diags)
+	}
+
+	expected := []string{"GET /services", "PATCH /services/existing-service-id", "GET /services/existing-service-id"}
+	if !reflect.DeepEqual(expected, requests) {
+		t.Fatalf("Expected %v, Got: %v for the requests made", expected, requests)
+	}
+
+	if d.Id() != "existing-service-id" || d.Get("slug") != "chow-hall" {
+		t.Fatalf("Expected the existing service to be adopted, Got: %s (%s)", d.Id(), d.Get("slug"))
+	}
+
+	if body["service_tier"] != float64(2) {
+		t.Fatalf("Expected the adopted service to be updated to the configured tier, Got: %+v", body)
+	}
+
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("Expected a warning that the service was adopted, Got: %+v", diags)
+	}
+}
+
+func TestImportServiceBySlug(t *testing.T) {
+	var queries []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		queries = append(queries, req.URL.Query().Get("query"))
+		w.Write([]byte(`{"data": [
+			{"id": "11111111-2222-3333-4444-555555555555", "name": "Checkout API", "slug": "checkout-api"},
+			{"id": "66666666-7777-8888-9999-000000000000", "name": "Checkout API Worker", "slug": "checkout-api-worker"},
+			{"id": "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee", "name": "Payments", "slug": "payments"},
+			{"id": "ffffffff-bbbb-cccc-dddd-eeeeeeeeeeee", "name": "Payments (legacy)", "slug": "payments"}
+		]}`))
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	importID := func(id string) (*schema.ResourceData, error) {
+		d := resourceService().TestResourceData()
+		d.SetId(id)
+
+		imported, err := importService(context.TODO(), d, ac)
+		if err != nil {
+			return nil, err
+		}
+
+		return imported[0], nil
+	}
+
+	d, err := importID("11111111-2222-3333-4444-555555555555")
+	if err != nil {
+		t.Fatalf("Received error importing a service by ID: %s", err.Error())
+	}
+	if d.Id() != "11111111-2222-3333-4444-555555555555" || len(queries) != 0 {
+		t.Fatalf("Expected a service ID to be imported without a lookup, Got: %s after %d lookups", d.Id(), len(queries))
+	}
+
+	d, err = importID("checkout-api")
+	if err != nil {
+		t.Fatalf("Received error importing a service by slug: %s", err.Error())
+	}
+	if d.Id() != "11111111-2222-3333-4444-555555555555" {
+		t.Fatalf("Expected the slug to resolve to the Checkout API service, Got: %s", d.Id())
+	}
+	if !reflect.DeepEqual(queries, []string{"checkout-api"}) {
+		t.Fatalf("Expected the slug to be searched for, Got: %v", queries)
+	}
+
+	_, err = importID("payments")
+	if err == nil {
+		t.Fatalf("Expected an error importing an ambiguous slug")
+	}
+	for _, expected := range []string{"aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee", "ffffffff-bbbb-cccc-dddd-eeeeeeeeeeee"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("Expected the error to list %s, Got: %s", expected, err.Error())
+		}
+	}
+
+	if _, err = importID("inventory"); err == nil {
+		t.Fatalf("Expected an error importing a slug that no service has")
+	}
+}
+
+func TestServiceDefaultLabels(t *testing.T) {
+	var sent map[string]string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method == "POST" {
+			body := struct {
+				Labels map[string]string `json:"labels"`
+			}{}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Errorf("Received error decoding the create: %s", err.Error())
+			}
+			sent = body.Labels
+		}
+
+		service := firehydrant.ServiceResponse{ID: "test-service-id", Name: "service", ServiceTier: 5, Labels: sent}
+		if err := json.NewEncoder(w).Encode(service); err != nil {
+			t.Errorf("Received error encoding the service: %s", err.Error())
+		}
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+	meta := &providerMeta{
+		Client:        ac,
+		defaultLabels: map[string]string{"cost_center": "engineering", "managed_by": "terraform"},
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":   "service",
+		"labels": map[string]interface{}{"team": "api", "cost_center": "platform"},
+	})
+
+	r := resourceService()
+	diff, err := r.Diff(context.TODO(), nil, config, meta)
+	if err != nil {
+		t.Fatalf("Received error planning the service: %s", err.Error())
+	}
+
+	state, diags := r.Apply(context.TODO(), nil, diff, meta)
+	if diags.HasError() {
+		t.Fatalf("Received error creating the service: %+v", diags)
+	}
+
+	// The service's own cost_center wins over the default
+	expected := map[string]string{"team": "api", "cost_center": "platform", "managed_by": "terraform"}
+	if !reflect.DeepEqual(expected, sent) {
+		t.Fatalf("Expected %+v, Got: %+v for the labels sent", expected, sent)
+	}
+
+	if got := state.Attributes["labels.%"]; got != "2" {
+		t.Errorf("Expected only the service's own 2 labels in labels, Got: %s", got)
+	}
+	if got := state.Attributes["labels_all.managed_by"]; got != "terraform" {
+		t.Errorf("Expected the default managed_by label in labels_all, Got: %q", got)
+	}
+
+	diff, err = r.Diff(context.TODO(), state, config, meta)
+	if err != nil {
+		t.Fatalf("Received error planning the service again: %s", err.Error())
+	}
+	if !diff.Empty() {
+		t.Fatalf("Expected no changes after creating the service, Got: %+v", diff.Attributes)
+	}
+}
+
+func TestReadServiceActiveIncidentsAndLastImport(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/services/imported-service-id":
+			w.Write([]byte(`{"id": "imported-service-id", "name": "imported", "status": "maintenance", "active_incidents": ["incident-1", "incident-2"], "last_import": {"id": "import-id", "created_at": "2021-06-01T02:00:00Z"}}`))
+		default:
+			w.Write([]byte(`{"id": "test-service-id", "name": "service"}`))
+		}
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	cases := map[string]struct {
+		activeIncidents []interface{}
+		lastImport      string
+		status          string
+	}{
+		"imported-service-id": {activeIncidents: []interface{}{"incident-1", "incident-2"}, lastImport: "2021-06-01T02:00:00Z", status: "maintenance"},
+		"test-service-id":     {activeIncidents: []interface{}{}, lastImport: "", status: ""},
+	}
+
+	for id, expected := range cases {
+		d := schema.TestResourceDataRaw(t, resourceService().Schema, map[string]interface{}{"name": "service"})
+		d.SetId(id)
+
+		if diags := readResourceFireHydrantService(context.TODO(), d, ac); diags.HasError() {
+			t.Fatalf("Received error reading service %s: %+v", id, diags)
+		}
+
+		if got := d.Get("active_incidents"); !reflect.DeepEqual(expected.activeIncidents, got) {
+			t.Errorf("Expected %+v, Got: %+v for active_incidents of %s", expected.activeIncidents, got, id)
+		}
+
+		if got := d.Get("last_import"); got != expected.lastImport {
+			t.Errorf("Expected %q, Got: %q for last_import of %s", expected.lastImport, got, id)
+		}
+
+		// Services FireHydrant reports no status for have an empty one
+		if got := d.Get("status"); got != expected.status {
+			t.Errorf("Expected %q, Got: %q for status of %s", expected.status, got, id)
+		}
+	}
+}
+
+func TestReadRemovesResourcesDeletedOutsideTerraform(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error": "Record not found"}`))
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	resources := map[string]*schema.Resource{
+		"firehydrant_service":       resourceService(),
+		"firehydrant_team":          resourceTeam(),
+		"firehydrant_functionality": resourceFunctionality(),
+		"firehydrant_environment":   resourceEnvironment(),
+		"firehydrant_severity":      resourceSeverity(),
+	}
+
+	for name, r := range resources {
+		d := r.TestResourceData()
+		d.SetId("deleted-id")
+
+		if diags := r.ReadContext(context.TODO(), d, ac); diags.HasError() {
+			t.Fatalf("Received error reading %s: %+v", name, diags)
+		}
+
+		if d.Id() != "" {
+			t.Errorf("Expected %s to be removed from state, Got ID: %q", name, d.Id())
+		}
+	}
+}
+
+func TestServiceDataSourceFunctionalities(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`{"id": "test-service-id", "name": "service", "functionalities": [
+			{"id": "checkout-id", "name": "Checkout"},
+			{"id": "login-id", "name": "Login"}
+		]}`))
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceService().Schema, map[string]interface{}{
+		"id": "test-service-id",
+	})
+
+	if diags := dataFireHydrantService(context.TODO(), d, ac); diags.HasError() {
+		t.Fatalf("Received error reading service: %+v", diags)
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{"id": "checkout-id", "name": "Checkout"},
+		map[string]interface{}{"id": "login-id", "name": "Login"},
+	}
+	if got := d.Get("functionalities"); !reflect.DeepEqual(expected, got) {
+		t.Fatalf("Expected %+v, Got: %+v for functionalities", expected, got)
+	}
+}
+
+func TestServicesDataSourceManagedBy(t *testing.T) {
+	var pages []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		page := req.URL.Query().Get("page")
+		pages = append(pages, page)
+
+		if page == "1" {
+			w.Write([]byte(`{"data": [{"id": "terraform-id", "name": "Checkout", "slug": "checkout"}], "pagination": {"page": 1, "pages": 2}}`))
+			return
+		}
+		w.Write([]byte(`{"data": [{"id": "synced-id", "name": "Payments", "slug": "payments", "managed_by": "pager_duty"}], "pagination": {"page": 2, "pages": 2}}`))
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceServices().Schema, map[string]interface{}{})
+	if diags := dataFireHydrantServices(context.TODO(), d, ac); diags.HasError() {
+		t.Fatalf("Received error reading services: %+v", diags)
+	}
+
+	if !reflect.DeepEqual([]string{"1", "2"}, pages) {
+		t.Fatalf("Expected every page to be requested, Got: %v", pages)
+	}
+
+	for index, expected := range []string{"", "pager_duty"} {
+		if got := d.Get(fmt.Sprintf("services.%d.managed_by", index)); got != expected {
+			t.Errorf("Expected managed_by %q for service %d, Got: %q", expected, index, got)
+		}
+	}
+}
+
+func TestCheckActorType(t *testing.T) {
+	user := firehydrant.Actor{ID: "user-id", Name: "Jane Doe", Type: "user"}
+	bot := firehydrant.Actor{ID: "bot-id", Name: "CI", Type: "bot"}
+
+	if diags := checkActorType(bot, "bot"); len(diags) != 0 {
+		t.Fatalf("Expected a bot to match, Got: %+v", diags)
+	}
+
+	if diags := checkActorType(user, "bot"); !diags.HasError() {
+		t.Fatalf("Expected an error for a user when a bot is expected, Got: %+v", diags)
+	}
+
+	os.Setenv("TF_IN_AUTOMATION", "1")
+	defer os.Unsetenv("TF_IN_AUTOMATION")
+
+	diags := checkActorType(user, "")
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("Expected a warning for a personal key in automation, Got: %+v", diags)
+	}
+
+	if diags := checkActorType(user, "user"); len(diags) != 0 {
+		t.Fatalf("Expected no warning once a user is expected, Got: %+v", diags)
+	}
+
+	if diags := checkActorType(bot, ""); len(diags) != 0 {
+		t.Fatalf("Expected no warning for a bot in automation, Got: %+v", diags)
+	}
+}
+
+func TestSuppressMarkdownWhitespaceDiff(t *testing.T) {
+	cases := []struct {
+		old, new string
+		suppress bool
+	}{
+		{old: "# Checkout\n\nHandles payments", new: "# Checkout\n\nHandles payments", suppress: true},
+		{old: "# Checkout\n\nHandles payments", new: "# Checkout\r\n\r\nHandles payments\n", suppress: true},
+		{old: "# Checkout\n\nHandles payments", new: "# Checkout  \n\nHandles payments\t\n\n", suppress: true},
+		{old: "# Checkout\n\nHandles payments", new: "# Checkout\nHandles payments", suppress: false},
+		{old: "- one\n  - two", new: "- one\n- two", suppress: false},
+		{old: "# Checkout", new: "# Payments", suppress: false},
+	}
+
+	for _, tc := range cases {
+		if got := suppressMarkdownWhitespaceDiff("description", tc.old, tc.new, nil); got != tc.suppress {
+			t.Errorf("suppressMarkdownWhitespaceDiff(%q, %q) = %t, expected %t", tc.old, tc.new, got, tc.suppress)
+		}
+	}
+}
+
+func TestValidateSlug(t *testing.T) {
+	for _, slug := range []string{"customer-impact", "p_1"} {
+		if _, errs := validateSlug(slug, "slug"); len(errs) != 0 {
+			t.Fatalf("Expected %q to be a valid slug, Got: %v", slug, errs)
+		}
+	}
+
+	for _, slug := range []string{"", "Checkout", "SEV1", "sev 1", "sev.1", "sév1"} {
+		_, errs := validateSlug(slug, "slug")
+		if len(errs) != 1 {
+			t.Fatalf("Expected %q to be an invalid slug", slug)
+		}
+
+		if expected := fmt.Sprintf("got %q", slug); !strings.Contains(errs[0].Error(), expected) {
+			t.Fatalf("Expected the error to show the slug, Got: %s", errs[0])
+		}
+	}
+}
+
+func TestValidateSeveritySlug(t *testing.T) {
+	for _, slug := range []string{"SEV1", "P1", "customer-impact", "p_1"} {
+		if _, errs := validateSeveritySlug(slug, "slug"); len(errs) != 0 {
+			t.Fatalf("Expected %q to be a valid severity slug, Got: %v", slug, errs)
+		}
+	}
+
+	for _, slug := range []string{"", "SEV 1", "sev.1", "sév1"} {
+		_, errs := validateSeveritySlug(slug, "slug")
+		if len(errs) != 1 {
+			t.Fatalf("Expected %q to be an invalid severity slug", slug)
+		}
+
+		if expected := fmt.Sprintf("got %q", slug); !strings.Contains(errs[0].Error(), expected) {
+			t.Fatalf("Expected the error to show the slug, Got: %s", errs[0])
+		}
+	}
+}
+
+func TestValidateLabels(t *testing.T) {
+	allowedLabels := allowedLabelsFromConfig([]interface{}{
+		map[string]interface{}{"key": "env", "values": schema.NewSet(schema.HashString, []interface{}{"prod", "staging"})},
+		map[string]interface{}{"key": "team", "values": schema.NewSet(schema.HashString, nil)},
+	})
+
+	if err := validateLabels(map[string]string{"env": "prod", "team": "anything"}, allowedLabels); err != nil {
+		t.Fatalf("Expected allowed labels to pass, Got: %s", err)
+	}
+
+	if err := validateLabels(map[string]string{"env": "prod"}, nil); err != nil {
+		t.Fatalf("Expected every label to be allowed without allowed_labels, Got: %s", err)
+	}
+
+	err := validateLabels(map[string]string{"environment": "production", "env": "production"}, allowedLabels)
+	if err == nil {
+		t.Fatalf("Expected labels missing from allowed_labels to fail")
+	}
+
+	expected := "service labels do not match allowed_labels:\n" +
+		"  label \"env\" can not be \"production\", use one of: prod, staging\n" +
+		"  label \"environment\" is not allowed, use one of: env, team"
+	if err.Error() != expected {
+		t.Fatalf("Expected %q, Got: %q", expected, err.Error())
+	}
+}
+
 func testFireHydrantIsSetup(t *testing.T) {
 	if v := os.Getenv("FIREHYDRANT_API_KEY"); v == "" {
 		t.Fatalf("Missing required environment variable: %s", "FIREHYDRANT_API_KEY")
@@ -101,6 +1023,22 @@ output "services" {
 }
 `
 
+const testServiceWithOwnerConfigTemplate = `
+resource "firehydrant_team" "owner" {
+	name = "%s"
+}
+
+resource "firehydrant_service" "terraform-acceptance-test-service" {
+	name = "%s"
+	description = "%s description"
+	owner_id = firehydrant_team.owner.id
+}
+`
+
+func testServiceWithOwnerConfig(rName string) string {
+	return fmt.Sprintf(testServiceWithOwnerConfigTemplate, rName, rName, rName)
+}
+
 func testServiceConfig(rName string) string {
 	return fmt.Sprintf(testServiceConfigTemplate, rName, rName)
 }
@@ -156,10 +1094,80 @@ func testServiceDoesNotExist(resourceName string) resource.TestCheckFunc {
 			return fmt.Errorf("The service existed, when it should not")
 		}
 
-		if _, isNotFound := err.(firehydrant.NotFound); !isNotFound {
+		if !firehydrant.IsNotFound(err) {
 			return err
 		}
 
 		return nil
 	}
 }
+
+func TestProtectManagedServices(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "test-service-id",
+		Attributes: map[string]string{
+			"id":           "test-service-id",
+			"name":         "service",
+			"description":  "",
+			"service_tier": "5",
+			"managed_by":   "pager_duty",
+		},
+	}
+	unchanged := terraform.NewResourceConfigRaw(map[string]interface{}{"name": "service"})
+	changed := terraform.NewResourceConfigRaw(map[string]interface{}{"name": "service", "description": "Changed in Terraform"})
+
+	r := resourceService()
+	protected := &providerMeta{protectManagedServices: true}
+
+	if _, err := r.Diff(context.TODO(), state, unchanged, protected); err != nil {
+		t.Fatalf("Expected no error planning a managed service without changes, Got: %s", err.Error())
+	}
+
+	_, err := r.Diff(context.TODO(), state, changed, protected)
+	if err == nil || !strings.Contains(err.Error(), "managed by pager_duty") {
+		t.Fatalf("Expected the change to a managed service to be refused, Got: %v", err)
+	}
+
+	if _, err := r.Diff(context.TODO(), state, changed, &providerMeta{}); err != nil {
+		t.Fatalf("Expected no error when protect_managed_services is disabled, Got: %s", err.Error())
+	}
+
+	state.Attributes["managed_by"] = "terraform"
+	if _, err := r.Diff(context.TODO(), state, changed, protected); err != nil {
+		t.Fatalf("Expected no error changing a service Terraform manages, Got: %s", err.Error())
+	}
+}
+
+func TestImportedServicePlansNoChanges(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`{"id": "11111111-2222-3333-4444-555555555555", "name": "Checkout API", "slug": "checkout-api", "service_tier": 5}`))
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	r := resourceService()
+	d := r.TestResourceData()
+	d.SetId("11111111-2222-3333-4444-555555555555")
+
+	imported, err := importService(context.TODO(), d, ac)
+	if err != nil {
+		t.Fatalf("Received error importing the service: %s", err.Error())
+	}
+
+	if diags := readResourceFireHydrantService(context.TODO(), imported[0], ac); diags.HasError() {
+		t.Fatalf("Received error reading the imported service: %+v", diags)
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"name": "Checkout API"})
+	diff, err := r.Diff(context.TODO(), imported[0].State(), config, ac)
+	if err != nil {
+		t.Fatalf("Received error planning the imported service: %s", err.Error())
+	}
+	if !diff.Empty() {
+		t.Fatalf("Expected no changes after importing the service, Got: %+v", diff.Attributes)
+	}
+}
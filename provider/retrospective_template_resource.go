@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceRetrospectiveTemplate() *schema.Resource {
+	return &schema.Resource{
+		Description:   "FireHydrant retrospective templates lay out the sections of an incident's retrospective, along with a prompt for each.",
+		CreateContext: createResourceFireHydrantRetrospectiveTemplate,
+		UpdateContext: updateResourceFireHydrantRetrospectiveTemplate,
+		ReadContext:   readResourceFireHydrantRetrospectiveTemplate,
+		DeleteContext: deleteResourceFireHydrantRetrospectiveTemplate,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sections": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"title": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"body": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The prompt shown to whoever fills in the section.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readResourceFireHydrantRetrospectiveTemplate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	r, err := ac.RetrospectiveTemplates().Get(ctx, d.Id())
+	if firehydrant.IsNotFound(err) {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := convertRetrospectiveTemplateToState(r, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func createResourceFireHydrantRetrospectiveTemplate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r := firehydrant.CreateRetrospectiveTemplateRequest{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Sections:    retrospectiveTemplateSectionsFromState(d),
+	}
+
+	resource, err := ac.RetrospectiveTemplates().Create(ctx, r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.ID)
+
+	if err := convertRetrospectiveTemplateToState(resource, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func updateResourceFireHydrantRetrospectiveTemplate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r := firehydrant.UpdateRetrospectiveTemplateRequest{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Sections:    retrospectiveTemplateSectionsFromState(d),
+	}
+
+	_, err := ac.RetrospectiveTemplates().Update(ctx, d.Id(), r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func deleteResourceFireHydrantRetrospectiveTemplate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	err := ac.RetrospectiveTemplates().Delete(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return diag.Diagnostics{}
+}
+
+func retrospectiveTemplateSectionsFromState(d *schema.ResourceData) []firehydrant.RetrospectiveTemplateSection {
+	sections := []firehydrant.RetrospectiveTemplateSection{}
+
+	for _, section := range d.Get("sections").([]interface{}) {
+		s := section.(map[string]interface{})
+		sections = append(sections, firehydrant.RetrospectiveTemplateSection{
+			Title: s["title"].(string),
+			Body:  s["body"].(string),
+		})
+	}
+
+	return sections
+}
+
+func convertRetrospectiveTemplateToState(retrospectiveTemplate *firehydrant.RetrospectiveTemplateResponse, d *schema.ResourceData) error {
+	attributes := map[string]interface{}{
+		"name":        retrospectiveTemplate.Name,
+		"description": retrospectiveTemplate.Description,
+	}
+
+	if err := setAttributesFromMap(d, attributes); err != nil {
+		return err
+	}
+
+	// Sections are kept in the order FireHydrant returns them, so reordering them is a change
+	sections := make([]interface{}, len(retrospectiveTemplate.Sections))
+	for index, section := range retrospectiveTemplate.Sections {
+		sections[index] = map[string]interface{}{
+			"title": section.Title,
+			"body":  section.Body,
+		}
+	}
+
+	return d.Set("sections", sections)
+}
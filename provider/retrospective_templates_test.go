@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccRetrospectiveTemplates(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testFireHydrantIsSetup(t) },
+		ProviderFactories: defaultProviderFactories(),
+		CheckDestroy:      testRetrospectiveTemplateDoesNotExist("firehydrant_retrospective_template.terraform-acceptance-test-retrospective-template"),
+		Steps: []resource.TestStep{
+			{
+				Config: testRetrospectiveTemplateConfig(rName, "Impact", "Timeline"),
+				Check: resource.ComposeTestCheckFunc(
+					testRetrospectiveTemplateExists("firehydrant_retrospective_template.terraform-acceptance-test-retrospective-template"),
+					resource.TestCheckResourceAttr("firehydrant_retrospective_template.terraform-acceptance-test-retrospective-template", "name", rName),
+					resource.TestCheckResourceAttr("firehydrant_retrospective_template.terraform-acceptance-test-retrospective-template", "sections.#", "2"),
+					resource.TestCheckResourceAttr("firehydrant_retrospective_template.terraform-acceptance-test-retrospective-template", "sections.0.title", "Impact"),
+					resource.TestCheckResourceAttr("firehydrant_retrospective_template.terraform-acceptance-test-retrospective-template", "sections.1.title", "Timeline"),
+				),
+			},
+			{
+				Config: testRetrospectiveTemplateConfig(rName, "Timeline", "Impact"),
+				Check: resource.ComposeTestCheckFunc(
+					testRetrospectiveTemplateExists("firehydrant_retrospective_template.terraform-acceptance-test-retrospective-template"),
+					resource.TestCheckResourceAttr("firehydrant_retrospective_template.terraform-acceptance-test-retrospective-template", "sections.0.title", "Timeline"),
+					resource.TestCheckResourceAttr("firehydrant_retrospective_template.terraform-acceptance-test-retrospective-template", "sections.1.title", "Impact"),
+				),
+			},
+		},
+	})
+}
+
+const testRetrospectiveTemplateConfigTemplate = `
+resource "firehydrant_retrospective_template" "terraform-acceptance-test-retrospective-template" {
+	name        = "%s"
+	description = "A retrospective template created by the acceptance tests"
+
+	sections {
+		title = "%s"
+	}
+
+	sections {
+		title = "%s"
+		body  = "What happened, and when?"
+	}
+}
+`
+
+func testRetrospectiveTemplateConfig(rName, firstSection, secondSection string) string {
+	return fmt.Sprintf(testRetrospectiveTemplateConfigTemplate, rName, firstSection, secondSection)
+}
+
+func testRetrospectiveTemplateExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		retrospectiveTemplate, err := c.RetrospectiveTemplates().Get(context.TODO(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if expected, got := rs.Primary.Attributes["sections.#"], fmt.Sprint(len(retrospectiveTemplate.Sections)); expected != got {
+			return fmt.Errorf("Expected %s sections, got %s", expected, got)
+		}
+
+		for index, section := range retrospectiveTemplate.Sections {
+			if expected, got := rs.Primary.Attributes[fmt.Sprintf("sections.%d.title", index)], section.Title; expected != got {
+				return fmt.Errorf("Expected section %d to be %s, got %s", index, expected, got)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testRetrospectiveTemplateDoesNotExist(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return nil
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		retrospectiveTemplate, err := c.RetrospectiveTemplates().Get(context.TODO(), rs.Primary.ID)
+		if retrospectiveTemplate != nil {
+			return fmt.Errorf("The retrospective template existed, when it should not")
+		}
+
+		if !firehydrant.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}
+}
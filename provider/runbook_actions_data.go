@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceRunbookActions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataFireHydrantRunbookActions,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The type of runbook the actions are for, such as incident.",
+			},
+			"integration_slug": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the actions of this integration, such as slack or jira_cloud.",
+			},
+			"actions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"integration_slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"required_config_keys": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataFireHydrantRunbookActions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	typ, integrationSlug := d.Get("type").(string), d.Get("integration_slug").(string)
+
+	r, err := ac.RunbookActions().List(ctx, typ)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	actions := make([]interface{}, 0)
+	for _, action := range r.Actions {
+		actionIntegrationSlug := ""
+		if action.Integration != nil {
+			actionIntegrationSlug = action.Integration.Slug
+		}
+
+		if integrationSlug != "" && actionIntegrationSlug != integrationSlug {
+			continue
+		}
+
+		requiredConfigKeys := []string{}
+		for _, field := range action.ConfigFields {
+			if field.Required {
+				requiredConfigKeys = append(requiredConfigKeys, field.Name)
+			}
+		}
+
+		actions = append(actions, map[string]interface{}{
+			"id":                   action.ID,
+			"name":                 action.Name,
+			"slug":                 action.Slug,
+			"integration_slug":     actionIntegrationSlug,
+			"required_config_keys": requiredConfigKeys,
+		})
+	}
+
+	if err := d.Set("actions", actions); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("does-not-matter")
+
+	return diag.Diagnostics{}
+}
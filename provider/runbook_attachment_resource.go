@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var runbookAttachmentConditions = []string{"service_ids", "environment_ids", "severity_ids"}
+
+func resourceRunbookAttachment() *schema.Resource {
+	return &schema.Resource{
+		Description:   "FireHydrant runbook attachments decide which incidents a runbook is attached to, so that it runs without being attached by hand.",
+		CreateContext: createResourceFireHydrantRunbookAttachment,
+		UpdateContext: updateResourceFireHydrantRunbookAttachment,
+		ReadContext:   readResourceFireHydrantRunbookAttachment,
+		DeleteContext: deleteResourceFireHydrantRunbookAttachment,
+		Importer: &schema.ResourceImporter{
+			StateContext: importParentID("runbook_id"),
+		},
+		Schema: map[string]*schema.Schema{
+			"runbook_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: runbookAttachmentConditions,
+				Description:  "Attach the runbook to incidents impacting any of these services.",
+			},
+			"environment_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: runbookAttachmentConditions,
+				Description:  "Attach the runbook to incidents impacting any of these environments.",
+			},
+			"severity_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: runbookAttachmentConditions,
+				Description:  "Attach the runbook to incidents with any of these severities.",
+			},
+		},
+	}
+}
+
+// A runbook has at most one attachment rule, so these use the runbook's ID as their own
+
+func readResourceFireHydrantRunbookAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	r, err := ac.Runbooks().GetAttachmentRule(ctx, d.Id())
+	if firehydrant.IsNotFound(err) {
+		// The runbook was detached or deleted outside of Terraform
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := convertRunbookAttachmentToState(r, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func createResourceFireHydrantRunbookAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	runbookID := d.Get("runbook_id").(string)
+
+	resource, err := ac.Runbooks().UpdateAttachmentRule(ctx, runbookID, runbookAttachmentFromState(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(runbookID)
+
+	if err := convertRunbookAttachmentToState(resource, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func updateResourceFireHydrantRunbookAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	_, err := ac.Runbooks().UpdateAttachmentRule(ctx, d.Id(), runbookAttachmentFromState(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func deleteResourceFireHydrantRunbookAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	err := ac.Runbooks().DeleteAttachmentRule(ctx, d.Id())
+	if err != nil && !firehydrant.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return diag.Diagnostics{}
+}
+
+func runbookRelationsFromSet(set *schema.Set) []firehydrant.RunbookRelation {
+	relations := []firehydrant.RunbookRelation{}
+	for _, id := range convertStringSet(set) {
+		relations = append(relations, firehydrant.RunbookRelation{ID: id})
+	}
+
+	return relations
+}
+
+func runbookRelationIDs(relations []firehydrant.RunbookRelation) []string {
+	ids := make([]string, len(relations))
+	for index, relation := range relations {
+		ids[index] = relation.ID
+	}
+
+	return ids
+}
+
+func runbookAttachmentFromState(d *schema.ResourceData) firehydrant.RunbookAttachmentRule {
+	return firehydrant.RunbookAttachmentRule{
+		Services:     runbookRelationsFromSet(d.Get("service_ids").(*schema.Set)),
+		Environments: runbookRelationsFromSet(d.Get("environment_ids").(*schema.Set)),
+		Severities:   runbookRelationsFromSet(d.Get("severity_ids").(*schema.Set)),
+	}
+}
+
+func convertRunbookAttachmentToState(rule *firehydrant.RunbookAttachmentRule, d *schema.ResourceData) error {
+	attributes := map[string]interface{}{
+		"service_ids":     runbookRelationIDs(rule.Services),
+		"environment_ids": runbookRelationIDs(rule.Environments),
+		"severity_ids":    runbookRelationIDs(rule.Severities),
+	}
+
+	return setAttributesFromMap(d, attributes)
+}
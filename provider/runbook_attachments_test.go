@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestRunbookAttachment(t *testing.T) {
+	var updated firehydrant.RunbookAttachmentRule
+	deleted := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/runbooks/test-runbook-id/attachment_rule" {
+			t.Errorf("Unexpected request %s %s", req.Method, req.URL.Path)
+		}
+
+		switch req.Method {
+		case http.MethodPut:
+			if err := json.NewDecoder(req.Body).Decode(&updated); err != nil {
+				t.Errorf("Could not decode request: %s", err)
+			}
+			json.NewEncoder(w).Encode(updated)
+		case http.MethodDelete:
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceRunbookAttachment().Schema, map[string]interface{}{
+		"runbook_id":   "test-runbook-id",
+		"service_ids":  []interface{}{"test-service-id"},
+		"severity_ids": []interface{}{"SEV1"},
+	})
+
+	if diags := createResourceFireHydrantRunbookAttachment(context.TODO(), d, ac); diags.HasError() {
+		t.Fatalf("Received error creating runbook attachment: %+v", diags)
+	}
+
+	expected := firehydrant.RunbookAttachmentRule{
+		Services:     []firehydrant.RunbookRelation{{ID: "test-service-id"}},
+		Environments: []firehydrant.RunbookRelation{},
+		Severities:   []firehydrant.RunbookRelation{{ID: "SEV1"}},
+	}
+	if !reflect.DeepEqual(expected, updated) {
+		t.Fatalf("Expected %+v, Got: %+v for the attachment rule sent", expected, updated)
+	}
+
+	if d.Id() != "test-runbook-id" {
+		t.Fatalf("Expected the runbook's ID to be used, Got: %s", d.Id())
+	}
+
+	if diags := deleteResourceFireHydrantRunbookAttachment(context.TODO(), d, ac); diags.HasError() {
+		t.Fatalf("Received error deleting runbook attachment: %+v", diags)
+	}
+
+	if !deleted {
+		t.Fatalf("Expected the runbook to be detached")
+	}
+}
@@ -2,10 +2,12 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceRunbook() *schema.Resource {
@@ -23,8 +25,10 @@ func resourceRunbook() *schema.Resource {
 				Required: true,
 			},
 			"type": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"incident", "infrastructure"}, false),
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -75,10 +79,17 @@ func resourceRunbook() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
-						"delation_duration": {
+						"delay_duration": {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						// The misspelled name the step delay used to have, kept so that existing configurations
+						// keep working. delay_duration wins when both are set
+						"delation_duration": {
+							Type:       schema.TypeString,
+							Optional:   true,
+							Deprecated: "Use delay_duration instead.",
+						},
 					},
 				},
 			},
@@ -89,10 +100,10 @@ func resourceRunbook() *schema.Resource {
 func readResourceFireHydrantRunbook(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ac := m.(firehydrant.Client)
 	r, err := ac.Runbooks().Get(ctx, d.Id())
-	if err != nil {
-		return diag.FromErr(err)
+	if firehydrant.IsNotFound(err) {
+		d.SetId("")
+		return diag.Diagnostics{}
 	}
-
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -127,17 +138,7 @@ func createResourceFireHydrantRunbook(ctx context.Context, d *schema.ResourceDat
 		Type:        typ,
 	}
 
-	steps := d.Get("steps").([]interface{})
-	for _, step := range steps {
-		s := step.(map[string]interface{})
-
-		r.Steps = append(r.Steps, firehydrant.RunbookStep{
-			Name:      s["name"].(string),
-			ActionID:  s["action_id"].(string),
-			Automatic: s["automatic"].(bool),
-			Config:    convertStringMap(s["config"].(map[string]interface{})),
-		})
-	}
+	r.Steps = runbookStepsFromState(d)
 
 	severities := d.Get("severities").([]interface{})
 	for _, sev := range severities {
@@ -183,17 +184,7 @@ func updateResourceFireHydrantRunbook(ctx context.Context, d *schema.ResourceDat
 		Description: description,
 	}
 
-	steps := d.Get("steps").([]interface{})
-	for _, step := range steps {
-		s := step.(map[string]interface{})
-
-		r.Steps = append(r.Steps, firehydrant.RunbookStep{
-			Name:      s["name"].(string),
-			ActionID:  s["action_id"].(string),
-			Automatic: s["automatic"].(bool),
-			Config:    convertStringMap(s["config"].(map[string]interface{})),
-		})
-	}
+	r.Steps = runbookStepsFromState(d)
 
 	severities := d.Get("severities").([]interface{})
 	for _, sev := range severities {
@@ -224,6 +215,32 @@ func deleteResourceFireHydrantRunbook(ctx context.Context, d *schema.ResourceDat
 	return diag.Diagnostics{}
 }
 
+// runbookStepsFromState builds the ordered list of runbook steps from the resource configuration
+func runbookStepsFromState(d *schema.ResourceData) []firehydrant.RunbookStep {
+	var steps []firehydrant.RunbookStep
+
+	for _, step := range d.Get("steps").([]interface{}) {
+		s := step.(map[string]interface{})
+
+		delayDuration := s["delay_duration"].(string)
+		if delayDuration == "" {
+			delayDuration = s["delation_duration"].(string)
+		}
+
+		steps = append(steps, firehydrant.RunbookStep{
+			Name:            s["name"].(string),
+			ActionID:        s["action_id"].(string),
+			Automatic:       s["automatic"].(bool),
+			Repeats:         s["repeats"].(bool),
+			RepeatsDuration: s["repeats_duration"].(string),
+			DelayDuration:   delayDuration,
+			Config:          convertStringMap(s["config"].(map[string]interface{})),
+		})
+	}
+
+	return steps
+}
+
 func convertRunbookToState(runbook *firehydrant.RunbookResponse, d *schema.ResourceData) error {
 	resourceSteps := make([]interface{}, len(runbook.Steps))
 	for index, s := range runbook.Steps {
@@ -232,13 +249,25 @@ func convertRunbookToState(runbook *firehydrant.RunbookResponse, d *schema.Resou
 			stepConfig[k] = v
 		}
 
-		resourceSteps[index] = map[string]interface{}{
-			"step_id":   s.StepID,
-			"name":      s.Name,
-			"action_id": s.ActionID,
-			"config":    stepConfig,
-			"automatic": s.Automatic,
+		resourceStep := map[string]interface{}{
+			"step_id":          s.StepID,
+			"name":             s.Name,
+			"action_id":        s.ActionID,
+			"config":           stepConfig,
+			"automatic":        s.Automatic,
+			"repeats":          s.Repeats,
+			"repeats_duration": s.RepeatsDuration,
+			"delay_duration":   s.DelayDuration,
 		}
+
+		// A step configured with the deprecated delation_duration keeps its delay there, so that it
+		// does not show a change until the configuration moves to delay_duration
+		if d.Get(fmt.Sprintf("steps.%d.delation_duration", index)).(string) != "" && d.Get(fmt.Sprintf("steps.%d.delay_duration", index)).(string) == "" {
+			resourceStep["delation_duration"] = s.DelayDuration
+			resourceStep["delay_duration"] = ""
+		}
+
+		resourceSteps[index] = resourceStep
 	}
 
 	if err := d.Set("steps", resourceSteps); err != nil {
@@ -2,8 +2,13 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
@@ -117,10 +122,102 @@ func testRunbookDoesNotExist(resourceName string) resource.TestCheckFunc {
 			return fmt.Errorf("The runbook existed, when it should not")
 		}
 
-		if _, isNotFound := err.(firehydrant.NotFound); !isNotFound {
+		if !firehydrant.IsNotFound(err) {
 			return err
 		}
 
 		return nil
 	}
 }
+
+func TestRunbookStepsRoundTrip(t *testing.T) {
+	var runbook firehydrant.RunbookResponse
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method == "POST" {
+			body := firehydrant.CreateRunbookRequest{}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Errorf("Received error decoding the create: %s", err.Error())
+			}
+
+			runbook = firehydrant.RunbookResponse{ID: "runbook-id", Name: body.Name, Type: body.Type, Steps: body.Steps}
+			for index := range runbook.Steps {
+				runbook.Steps[index].StepID = fmt.Sprintf("step-%d", index)
+			}
+		}
+
+		if err := json.NewEncoder(w).Encode(runbook); err != nil {
+			t.Errorf("Received error encoding the runbook: %s", err.Error())
+		}
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "runbook",
+		"type": "incident",
+		"steps": []interface{}{
+			map[string]interface{}{
+				"name":             "Notify",
+				"action_id":        "notify-action",
+				"config":           map[string]interface{}{"channel": "#incidents"},
+				"automatic":        true,
+				"repeats":          true,
+				"repeats_duration": "PT10M",
+				"delay_duration":   "PT5M",
+			},
+			// Configurations written before the rename still set delation_duration
+			map[string]interface{}{
+				"name":              "Page",
+				"action_id":         "page-action",
+				"delation_duration": "PT1M",
+			},
+		},
+	})
+
+	r := resourceRunbook()
+	diff, err := r.Diff(context.TODO(), nil, config, ac)
+	if err != nil {
+		t.Fatalf("Received error planning the runbook: %s", err.Error())
+	}
+
+	state, diags := r.Apply(context.TODO(), nil, diff, ac)
+	if diags.HasError() {
+		t.Fatalf("Received error creating the runbook: %+v", diags)
+	}
+
+	expected := []firehydrant.RunbookStep{
+		{Name: "Notify", ActionID: "notify-action", StepID: "step-0", Config: map[string]string{"channel": "#incidents"}, Automatic: true, Repeats: true, RepeatsDuration: "PT10M", DelayDuration: "PT5M"},
+		{Name: "Page", ActionID: "page-action", StepID: "step-1", DelayDuration: "PT1M"},
+	}
+	if !reflect.DeepEqual(expected, runbook.Steps) {
+		t.Fatalf("Expected %+v, Got: %+v for the steps sent", expected, runbook.Steps)
+	}
+
+	if got := state.Attributes["steps.1.delation_duration"]; got != "PT1M" {
+		t.Errorf("Expected the delay to stay in delation_duration, Got: %q", got)
+	}
+
+	diff, err = r.Diff(context.TODO(), state, config, ac)
+	if err != nil {
+		t.Fatalf("Received error planning the runbook again: %s", err.Error())
+	}
+	if !diff.Empty() {
+		t.Fatalf("Expected no changes after creating the runbook, Got: %+v", diff.Attributes)
+	}
+}
+
+func TestRunbookInvalidType(t *testing.T) {
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "runbook",
+		"type": "incidents",
+	})
+
+	diags := resourceRunbook().Validate(config)
+	if !diags.HasError() || !strings.Contains(fmt.Sprintf("%+v", diags), "expected type to be one of [incident infrastructure]") {
+		t.Fatalf("Expected an error for the invalid runbook type, Got: %+v", diags)
+	}
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSavedSearch() *schema.Resource {
+	return &schema.Resource{
+		Description:   "FireHydrant saved searches keep a set of filters, such as the SEV1 incidents on a service, for reports to be built from.",
+		CreateContext: createResourceFireHydrantSavedSearch,
+		ReadContext:   readResourceFireHydrantSavedSearch,
+		DeleteContext: deleteResourceFireHydrantSavedSearch,
+		Importer: &schema.ResourceImporter{
+			StateContext: importScopedResource("resource_type"),
+		},
+		// FireHydrant does not change saved searches, so any change saves a new one
+		Schema: map[string]*schema.Schema{
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"incidents", "change_events"}, false),
+				Description:  "The kind of resource the search is for, incidents or change_events.",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"filter_values": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJSONDiff,
+				Description:      "The filters the search applies as a JSON object, such as `jsonencode({ severities = \"SEV1\" })`.",
+			},
+			"private": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Only show the search to the owner of the API key that saved it.",
+			},
+		},
+	}
+}
+
+func readResourceFireHydrantSavedSearch(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	r, err := ac.SavedSearches().Get(ctx, d.Get("resource_type").(string), d.Id())
+	if firehydrant.IsNotFound(err) {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := convertSavedSearchToState(r, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func createResourceFireHydrantSavedSearch(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	filterValues := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(d.Get("filter_values").(string)), &filterValues); err != nil {
+		return diag.Errorf("filter_values must be a JSON object: %s", err)
+	}
+
+	r := firehydrant.CreateSavedSearchRequest{
+		Name:         d.Get("name").(string),
+		IsPrivate:    d.Get("private").(bool),
+		FilterValues: filterValues,
+	}
+
+	resource, err := ac.SavedSearches().Create(ctx, d.Get("resource_type").(string), r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.ID)
+
+	if err := convertSavedSearchToState(resource, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func deleteResourceFireHydrantSavedSearch(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	err := ac.SavedSearches().Delete(ctx, d.Get("resource_type").(string), d.Id())
+	if err != nil && !firehydrant.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return diag.Diagnostics{}
+}
+
+// suppressEquivalentJSONDiff ignores differences in how JSON is written, such as key order or spacing
+func suppressEquivalentJSONDiff(k, old, new string, d *schema.ResourceData) bool {
+	var oldValue, newValue interface{}
+	if err := json.Unmarshal([]byte(old), &oldValue); err != nil {
+		return false
+	}
+
+	if err := json.Unmarshal([]byte(new), &newValue); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(oldValue, newValue)
+}
+
+func convertSavedSearchToState(search *firehydrant.SavedSearchResponse, d *schema.ResourceData) error {
+	filterValues, err := json.Marshal(search.FilterValues)
+	if err != nil {
+		return err
+	}
+
+	attributes := map[string]interface{}{
+		"name":          search.Name,
+		"private":       search.IsPrivate,
+		"filter_values": string(filterValues),
+	}
+
+	return setAttributesFromMap(d, attributes)
+}
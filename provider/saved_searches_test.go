@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestCreateSavedSearch(t *testing.T) {
+	var created firehydrant.CreateSavedSearchRequest
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost || req.URL.Path != "/saved_searches/incidents" {
+			t.Errorf("Unexpected request %s %s", req.Method, req.URL.Path)
+		}
+
+		if err := json.NewDecoder(req.Body).Decode(&created); err != nil {
+			t.Errorf("Could not decode request: %s", err)
+		}
+		json.NewEncoder(w).Encode(firehydrant.SavedSearchResponse{
+			ID:           "saved-search-id",
+			Name:         created.Name,
+			ResourceType: "incidents",
+			FilterValues: created.FilterValues,
+		})
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceSavedSearch().Schema, map[string]interface{}{
+		"resource_type": "incidents",
+		"name":          "Checkout SEV1s",
+		"filter_values": `{"severities": "SEV1", "services": ["checkout-service"]}`,
+	})
+
+	if diags := createResourceFireHydrantSavedSearch(context.TODO(), d, ac); diags.HasError() {
+		t.Fatalf("Received error creating saved search: %+v", diags)
+	}
+
+	expected := map[string]interface{}{"severities": "SEV1", "services": []interface{}{"checkout-service"}}
+	if !reflect.DeepEqual(expected, created.FilterValues) {
+		t.Fatalf("Expected %+v, Got: %+v for the filter values sent", expected, created.FilterValues)
+	}
+
+	if d.Id() != "saved-search-id" {
+		t.Fatalf("Expected saved-search-id, Got: %s for the saved search ID", d.Id())
+	}
+
+	if !suppressEquivalentJSONDiff("filter_values", d.Get("filter_values").(string), `{"services":["checkout-service"],"severities":"SEV1"}`, d) {
+		t.Fatalf("Expected %s read back from FireHydrant to match the configured filter values", d.Get("filter_values"))
+	}
+}
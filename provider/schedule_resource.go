@@ -0,0 +1,280 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	// Embed the IANA time zone database so time_zone validation doesn't depend on the host
+	_ "time/tzdata"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSchedule() *schema.Resource {
+	return &schema.Resource{
+		Description:   "FireHydrant on-call schedules define who on a team is on call and when the rotation hands off.",
+		CreateContext: createResourceFireHydrantSchedule,
+		UpdateContext: updateResourceFireHydrantSchedule,
+		ReadContext:   readResourceFireHydrantSchedule,
+		DeleteContext: deleteResourceFireHydrantSchedule,
+		CustomizeDiff: checkScheduleStrategy,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(slowResourceTimeout),
+			Update: schema.DefaultTimeout(slowResourceTimeout),
+			Delete: schema.DefaultTimeout(slowResourceTimeout),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importScopedResource("team_id"),
+		},
+		Schema: map[string]*schema.Schema{
+			"team_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"time_zone": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateTimeZone,
+			},
+			"strategy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"daily", "weekly", "custom"}, false),
+						},
+						"handoff_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([01]\d|2[0-3]):[0-5]\d(:[0-5]\d)?$`), "must be a time of day formatted as HH:MM or HH:MM:SS"),
+						},
+						"handoff_day": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday",
+							}, false),
+						},
+						"shift_duration": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^P((\d+[WD])+(T(\d+[HMS])+)?|T(\d+[HMS])+)$`), "must be an ISO 8601 duration such as PT12H or P2D"),
+							Description:  "How long each shift of a custom strategy lasts, as an ISO 8601 duration such as PT12H or P2D.",
+						},
+					},
+				},
+			},
+			"member_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func readResourceFireHydrantSchedule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	r, err := ac.Schedules().Get(ctx, d.Get("team_id").(string), d.Id())
+	if firehydrant.IsNotFound(err) {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := convertScheduleToState(r, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func createResourceFireHydrantSchedule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ctx = withResourceTimeout(ctx, d, schema.TimeoutCreate)
+	ac := m.(firehydrant.Client)
+
+	r := firehydrant.CreateScheduleRequest{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		TimeZone:    d.Get("time_zone").(string),
+		Strategy:    scheduleStrategyFromState(d),
+		MemberIDs:   scheduleMemberIDsFromState(d),
+	}
+
+	resource, err := ac.Schedules().Create(ctx, d.Get("team_id").(string), r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.ID)
+
+	if err := convertScheduleToState(resource, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func updateResourceFireHydrantSchedule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ctx = withResourceTimeout(ctx, d, schema.TimeoutUpdate)
+	ac := m.(firehydrant.Client)
+	strategy := scheduleStrategyFromState(d)
+
+	r := firehydrant.UpdateScheduleRequest{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		TimeZone:    d.Get("time_zone").(string),
+		Strategy:    &strategy,
+		MemberIDs:   scheduleMemberIDsFromState(d),
+	}
+
+	_, err := ac.Schedules().Update(ctx, d.Get("team_id").(string), d.Id(), r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func deleteResourceFireHydrantSchedule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ctx = withResourceTimeout(ctx, d, schema.TimeoutDelete)
+	ac := m.(firehydrant.Client)
+
+	err := ac.Schedules().Delete(ctx, d.Get("team_id").(string), d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return diag.Diagnostics{}
+}
+
+func scheduleStrategyFromState(d *schema.ResourceData) firehydrant.ScheduleStrategy {
+	strategy := firehydrant.ScheduleStrategy{}
+
+	strategies := d.Get("strategy").([]interface{})
+	if len(strategies) == 0 || strategies[0] == nil {
+		return strategy
+	}
+
+	s := strategies[0].(map[string]interface{})
+	strategy.Type = s["type"].(string)
+	strategy.HandoffTime = s["handoff_time"].(string)
+	strategy.HandoffDay = s["handoff_day"].(string)
+	strategy.ShiftDuration = s["shift_duration"].(string)
+
+	return strategy
+}
+
+func scheduleMemberIDsFromState(d *schema.ResourceData) []string {
+	memberIDs := []string{}
+	for _, id := range d.Get("member_ids").([]interface{}) {
+		memberIDs = append(memberIDs, id.(string))
+	}
+
+	return memberIDs
+}
+
+func convertScheduleToState(schedule *firehydrant.ScheduleResponse, d *schema.ResourceData) error {
+	attributes := map[string]interface{}{
+		"name":        schedule.Name,
+		"description": schedule.Description,
+		"time_zone":   schedule.TimeZone,
+	}
+
+	if err := setAttributesFromMap(d, attributes); err != nil {
+		return err
+	}
+
+	strategy := []interface{}{
+		map[string]interface{}{
+			"type":           schedule.Strategy.Type,
+			"handoff_time":   schedule.Strategy.HandoffTime,
+			"handoff_day":    schedule.Strategy.HandoffDay,
+			"shift_duration": schedule.Strategy.ShiftDuration,
+		},
+	}
+	if err := d.Set("strategy", strategy); err != nil {
+		return err
+	}
+
+	memberIDs := make([]interface{}, len(schedule.Members))
+	for index, member := range schedule.Members {
+		memberIDs[index] = member.ID
+	}
+
+	return d.Set("member_ids", memberIDs)
+}
+
+// scheduleStrategyRequiredFields are the strategy settings each type of strategy can't do without
+var scheduleStrategyRequiredFields = map[string][]string{
+	"daily":  {"handoff_time"},
+	"weekly": {"handoff_time", "handoff_day"},
+	"custom": {"shift_duration"},
+}
+
+// checkScheduleStrategy catches strategies missing a setting their type needs when planning, since
+// FireHydrant only rejects them once they are applied. Settings that aren't known until apply are
+// left for FireHydrant to check
+func checkScheduleStrategy(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("strategy.0.type") {
+		return nil
+	}
+
+	strategyType := d.Get("strategy.0.type").(string)
+
+	var missing []string
+	for _, field := range scheduleStrategyRequiredFields[strategyType] {
+		key := "strategy.0." + field
+		if d.NewValueKnown(key) && d.Get(key).(string) == "" {
+			missing = append(missing, field)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("a %s strategy needs %s to be set", strategyType, strings.Join(missing, " and "))
+	}
+
+	return nil
+}
+
+// validateTimeZone ensures a value is a time zone name from the IANA time zone database
+func validateTimeZone(v interface{}, k string) ([]string, []error) {
+	value, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if value == "" || value == "Local" {
+		return nil, []error{fmt.Errorf("%s must be an IANA time zone name, got %q", k, value)}
+	}
+
+	if _, err := time.LoadLocation(value); err != nil {
+		return nil, []error{fmt.Errorf("%s must be an IANA time zone name, got %q", k, value)}
+	}
+
+	return nil, nil
+}
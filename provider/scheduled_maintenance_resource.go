@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceScheduledMaintenance() *schema.Resource {
+	return &schema.Resource{
+		Description:   "FireHydrant scheduled maintenances declare planned maintenance windows for the services they affect.",
+		CreateContext: createResourceFireHydrantScheduledMaintenance,
+		UpdateContext: updateResourceFireHydrantScheduledMaintenance,
+		ReadContext:   readResourceFireHydrantScheduledMaintenance,
+		DeleteContext: deleteResourceFireHydrantScheduledMaintenance,
+		CustomizeDiff: customizeDiffFireHydrantScheduledMaintenance,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"starts_at": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339Diff,
+				Description:      "When the maintenance starts, as an RFC3339 time such as \"2021-06-01T02:00:00Z\".",
+			},
+			"ends_at": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339Diff,
+				Description:      "When the maintenance ends, as an RFC3339 time. Must be after starts_at.",
+			},
+			"service_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the services the maintenance affects.",
+			},
+		},
+	}
+}
+
+func readResourceFireHydrantScheduledMaintenance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	r, err := ac.ScheduledMaintenances().Get(ctx, d.Id())
+	if firehydrant.IsNotFound(err) {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := convertScheduledMaintenanceToState(r, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func createResourceFireHydrantScheduledMaintenance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	startsAt, endsAt, err := scheduledMaintenanceWindowFromState(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	r := firehydrant.CreateScheduledMaintenanceRequest{
+		Title:       d.Get("title").(string),
+		Description: d.Get("description").(string),
+		StartsAt:    startsAt,
+		EndsAt:      endsAt,
+		Services:    convertStringSet(d.Get("service_ids").(*schema.Set)),
+	}
+
+	resource, err := ac.ScheduledMaintenances().Create(ctx, r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.ID)
+
+	if err := convertScheduledMaintenanceToState(resource, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func updateResourceFireHydrantScheduledMaintenance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	startsAt, endsAt, err := scheduledMaintenanceWindowFromState(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	r := firehydrant.UpdateScheduledMaintenanceRequest{
+		Title:       d.Get("title").(string),
+		Description: d.Get("description").(string),
+		StartsAt:    startsAt,
+		EndsAt:      endsAt,
+		Services:    convertStringSet(d.Get("service_ids").(*schema.Set)),
+	}
+
+	_, err = ac.ScheduledMaintenances().Update(ctx, d.Id(), r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func deleteResourceFireHydrantScheduledMaintenance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	err := ac.ScheduledMaintenances().Delete(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return diag.Diagnostics{}
+}
+
+// customizeDiffFireHydrantScheduledMaintenance checks the maintenance window at plan time. Times that are
+// not known until apply are left for the next plan to check
+func customizeDiffFireHydrantScheduledMaintenance(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("starts_at") || !d.NewValueKnown("ends_at") {
+		return nil
+	}
+
+	return checkScheduledMaintenanceWindow(d.Get("starts_at").(string), d.Get("ends_at").(string), d.Id() == "", time.Now())
+}
+
+// checkScheduledMaintenanceWindow makes sure a maintenance starts before it ends, and that a new maintenance
+// is not scheduled in the past. Windows that have already started are left alone once they exist
+func checkScheduledMaintenanceWindow(startsAt, endsAt string, creating bool, now time.Time) error {
+	start, err := time.Parse(time.RFC3339, startsAt)
+	if err != nil {
+		return fmt.Errorf("starts_at %q is not an RFC3339 time: %w", startsAt, err)
+	}
+
+	end, err := time.Parse(time.RFC3339, endsAt)
+	if err != nil {
+		return fmt.Errorf("ends_at %q is not an RFC3339 time: %w", endsAt, err)
+	}
+
+	if !start.Before(end) {
+		return fmt.Errorf("starts_at (%s) must be before ends_at (%s)", startsAt, endsAt)
+	}
+
+	if creating && start.Before(now) {
+		return fmt.Errorf("starts_at (%s) is in the past, scheduled maintenances can only be created for future windows", startsAt)
+	}
+
+	return nil
+}
+
+func scheduledMaintenanceWindowFromState(d *schema.ResourceData) (time.Time, time.Time, error) {
+	startsAt, err := time.Parse(time.RFC3339, d.Get("starts_at").(string))
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	endsAt, err := time.Parse(time.RFC3339, d.Get("ends_at").(string))
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	return startsAt, endsAt, nil
+}
+
+// suppressEquivalentRFC3339Diff ignores a time being written differently, such as FireHydrant returning
+// it in UTC, as long as it is the same instant
+func suppressEquivalentRFC3339Diff(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+
+	return oldTime.Equal(newTime)
+}
+
+func convertScheduledMaintenanceToState(maintenance *firehydrant.ScheduledMaintenanceResponse, d *schema.ResourceData) error {
+	serviceIDs := make([]string, len(maintenance.Services))
+	for index, service := range maintenance.Services {
+		serviceIDs[index] = service.ID
+	}
+
+	attributes := map[string]interface{}{
+		"title":       maintenance.Title,
+		"description": maintenance.Description,
+		"starts_at":   maintenance.StartsAt.Format(time.RFC3339),
+		"ends_at":     maintenance.EndsAt.Format(time.RFC3339),
+		"service_ids": serviceIDs,
+	}
+
+	return setAttributesFromMap(d, attributes)
+}
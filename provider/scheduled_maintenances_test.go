@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccScheduledMaintenances(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	startsAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Hour)
+	endsAt := startsAt.Add(2 * time.Hour)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testFireHydrantIsSetup(t) },
+		ProviderFactories: defaultProviderFactories(),
+		CheckDestroy:      testScheduledMaintenanceDoesNotExist("firehydrant_scheduled_maintenance.terraform-acceptance-test-maintenance"),
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduledMaintenanceConfig(rName, startsAt, endsAt),
+				Check: resource.ComposeTestCheckFunc(
+					testScheduledMaintenanceExists("firehydrant_scheduled_maintenance.terraform-acceptance-test-maintenance"),
+					resource.TestCheckResourceAttr("firehydrant_scheduled_maintenance.terraform-acceptance-test-maintenance", "title", rName),
+					resource.TestCheckResourceAttr("firehydrant_scheduled_maintenance.terraform-acceptance-test-maintenance", "service_ids.#", "1"),
+				),
+			},
+			{
+				Config: testScheduledMaintenanceConfig(rName, startsAt, endsAt.Add(time.Hour)),
+				Check: resource.ComposeTestCheckFunc(
+					testScheduledMaintenanceExists("firehydrant_scheduled_maintenance.terraform-acceptance-test-maintenance"),
+					resource.TestCheckResourceAttr("firehydrant_scheduled_maintenance.terraform-acceptance-test-maintenance", "ends_at", endsAt.Add(time.Hour).Format(time.RFC3339)),
+				),
+			},
+		},
+	})
+}
+
+func TestCheckScheduledMaintenanceWindow(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		startsAt string
+		endsAt   string
+		creating bool
+		err      string
+	}{
+		{name: "future window", startsAt: "2021-06-02T02:00:00Z", endsAt: "2021-06-02T04:00:00Z", creating: true},
+		{name: "offsets are compared as instants", startsAt: "2021-06-02T06:00:00+05:00", endsAt: "2021-06-02T04:00:00Z", creating: true},
+		{name: "ends before it starts", startsAt: "2021-06-02T04:00:00Z", endsAt: "2021-06-02T02:00:00Z", creating: true, err: "must be before ends_at"},
+		{name: "ends when it starts", startsAt: "2021-06-02T02:00:00Z", endsAt: "2021-06-02T02:00:00Z", creating: true, err: "must be before ends_at"},
+		{name: "past window", startsAt: "2021-05-01T02:00:00Z", endsAt: "2021-05-01T04:00:00Z", creating: true, err: "is in the past"},
+		{name: "existing past window", startsAt: "2021-05-01T02:00:00Z", endsAt: "2021-05-01T04:00:00Z", creating: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := checkScheduledMaintenanceWindow(c.startsAt, c.endsAt, c.creating, now)
+			if c.err == "" {
+				if err != nil {
+					t.Fatalf("Expected no error, Got: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Fatalf("Expected an error containing %q, Got: %v", c.err, err)
+			}
+		})
+	}
+}
+
+const testScheduledMaintenanceConfigTemplate = `
+resource "firehydrant_service" "terraform-acceptance-test-service" {
+	name = "%s"
+}
+
+resource "firehydrant_scheduled_maintenance" "terraform-acceptance-test-maintenance" {
+	title       = "%s"
+	description = "A scheduled maintenance created by the acceptance tests"
+	starts_at   = "%s"
+	ends_at     = "%s"
+	service_ids = [firehydrant_service.terraform-acceptance-test-service.id]
+}
+`
+
+func testScheduledMaintenanceConfig(rName string, startsAt, endsAt time.Time) string {
+	return fmt.Sprintf(testScheduledMaintenanceConfigTemplate, rName, rName, startsAt.Format(time.RFC3339), endsAt.Format(time.RFC3339))
+}
+
+func testScheduledMaintenanceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		maintenance, err := c.ScheduledMaintenances().Get(context.TODO(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if expected, got := rs.Primary.Attributes["title"], maintenance.Title; expected != got {
+			return fmt.Errorf("Unexpected title. Expected: %s, got: %s", expected, got)
+		}
+
+		return nil
+	}
+}
+
+func testScheduledMaintenanceDoesNotExist(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return nil
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		maintenance, err := c.ScheduledMaintenances().Get(context.TODO(), rs.Primary.ID)
+		if maintenance != nil {
+			return fmt.Errorf("The scheduled maintenance existed, when it should not")
+		}
+
+		if !firehydrant.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}
+}
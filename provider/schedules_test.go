@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestCheckScheduleStrategy(t *testing.T) {
+	cases := []struct {
+		strategy map[string]interface{}
+		err      string
+	}{
+		{strategy: map[string]interface{}{"type": "daily", "handoff_time": "09:00"}},
+		{strategy: map[string]interface{}{"type": "daily"}, err: "a daily strategy needs handoff_time to be set"},
+		{strategy: map[string]interface{}{"type": "weekly", "handoff_time": "09:00", "handoff_day": "monday"}},
+		{strategy: map[string]interface{}{"type": "weekly", "handoff_time": "09:00"}, err: "a weekly strategy needs handoff_day to be set"},
+		{strategy: map[string]interface{}{"type": "weekly"}, err: "a weekly strategy needs handoff_time and handoff_day to be set"},
+		{strategy: map[string]interface{}{"type": "custom", "shift_duration": "PT12H"}},
+		{strategy: map[string]interface{}{"type": "custom"}, err: "a custom strategy needs shift_duration to be set"},
+	}
+
+	for _, tc := range cases {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"team_id":   "test-team-id",
+			"name":      "schedule",
+			"time_zone": "America/New_York",
+			"strategy":  []interface{}{tc.strategy},
+		})
+
+		_, err := resourceSchedule().Diff(context.TODO(), nil, config, nil)
+		if tc.err == "" && err != nil {
+			t.Errorf("Expected %+v to be accepted, Got: %s", tc.strategy, err)
+		}
+		if tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+			t.Errorf("Expected %+v to fail with %q, Got: %v", tc.strategy, tc.err, err)
+		}
+	}
+}
+
+func TestAccSchedules(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	rNameUpdated := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testFireHydrantIsSetup(t) },
+		ProviderFactories: defaultProviderFactories(),
+		CheckDestroy:      testScheduleDoesNotExist("firehydrant_schedule.terraform-acceptance-test-schedule"),
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleConfig(rName, "America/New_York"),
+				Check: resource.ComposeTestCheckFunc(
+					testScheduleExists("firehydrant_schedule.terraform-acceptance-test-schedule"),
+					resource.TestCheckResourceAttr("firehydrant_schedule.terraform-acceptance-test-schedule", "name", rName),
+					resource.TestCheckResourceAttr("firehydrant_schedule.terraform-acceptance-test-schedule", "time_zone", "America/New_York"),
+					resource.TestCheckResourceAttr("firehydrant_schedule.terraform-acceptance-test-schedule", "strategy.0.type", "weekly"),
+				),
+			},
+			{
+				Config: testScheduleConfig(rNameUpdated, "Europe/London"),
+				Check: resource.ComposeTestCheckFunc(
+					testScheduleExists("firehydrant_schedule.terraform-acceptance-test-schedule"),
+					resource.TestCheckResourceAttr("firehydrant_schedule.terraform-acceptance-test-schedule", "name", rNameUpdated),
+					resource.TestCheckResourceAttr("firehydrant_schedule.terraform-acceptance-test-schedule", "time_zone", "Europe/London"),
+				),
+			},
+			{
+				Config:      testScheduleConfig(rNameUpdated, "Mars/Olympus_Mons"),
+				ExpectError: regexp.MustCompile("must be an IANA time zone name"),
+			},
+		},
+	})
+}
+
+const testScheduleConfigTemplate = `
+resource "firehydrant_team" "team" {
+	name = "%s"
+}
+
+resource "firehydrant_schedule" "terraform-acceptance-test-schedule" {
+	team_id   = firehydrant_team.team.id
+	name      = "%s"
+	time_zone = "%s"
+
+	strategy {
+		type         = "weekly"
+		handoff_time = "09:00:00"
+		handoff_day  = "monday"
+	}
+}
+`
+
+func testScheduleConfig(rName, timeZone string) string {
+	return fmt.Sprintf(testScheduleConfigTemplate, rName, rName, timeZone)
+}
+
+func testScheduleExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		schedule, err := c.Schedules().Get(context.TODO(), rs.Primary.Attributes["team_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if expected, got := rs.Primary.Attributes["name"], schedule.Name; expected != got {
+			return fmt.Errorf("Expected name %s, got %s", expected, got)
+		}
+
+		if expected, got := rs.Primary.Attributes["time_zone"], schedule.TimeZone; expected != got {
+			return fmt.Errorf("Expected time_zone %s, got %s", expected, got)
+		}
+
+		return nil
+	}
+}
+
+func testScheduleDoesNotExist(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return nil
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		schedule, err := c.Schedules().Get(context.TODO(), rs.Primary.Attributes["team_id"], rs.Primary.ID)
+		if schedule != nil {
+			return fmt.Errorf("The on-call schedule existed, when it should not")
+		}
+
+		if !firehydrant.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}
+}
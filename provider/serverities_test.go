@@ -3,6 +3,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
 	"testing"
@@ -11,6 +13,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -27,6 +30,15 @@ func TestAccSeverities(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testSeverityExists("firehydrant_severity.terraform-acceptance-test-severity"),
 					resource.TestCheckResourceAttr("firehydrant_severity.terraform-acceptance-test-severity", "slug", strings.ToUpper(rName)),
+					resource.TestCheckResourceAttrSet("firehydrant_severity.terraform-acceptance-test-severity", "type"),
+				),
+			},
+			{
+				Config: testSeverityWithTypeConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testSeverityExists("firehydrant_severity.terraform-acceptance-test-severity"),
+					resource.TestCheckResourceAttr("firehydrant_severity.terraform-acceptance-test-severity", "type", "maintenance"),
+					resource.TestCheckResourceAttr("firehydrant_severity.terraform-acceptance-test-severity", "color", "#0000ff"),
 				),
 			},
 			// TODO(bobbytables): Updating severities in Terraform is currently problematic because FireHydrant uses
@@ -43,12 +55,96 @@ func TestAccSeverities(t *testing.T) {
 	})
 }
 
+func TestImportSeverity(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !strings.EqualFold(req.URL.Path, "/severities/SEV1") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"slug": "SEV1", "description": "Checkout is down", "type": "unexpected_downtime", "color": "#ff0000"}`))
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	d := resourceSeverity().TestResourceData()
+	d.SetId("sev1")
+
+	imported, err := importSeverity(context.TODO(), d, ac)
+	if err != nil {
+		t.Fatalf("Received error importing severity: %s", err)
+	}
+
+	d = imported[0]
+	if d.Id() != "SEV1" {
+		t.Fatalf("Expected the slug FireHydrant returned to be used as the ID, Got: %s", d.Id())
+	}
+
+	if diags := readResourceFireHydrantSeverity(context.TODO(), d, ac); diags.HasError() {
+		t.Fatalf("Received error reading imported severity: %+v", diags)
+	}
+
+	if got := d.Get("description"); got != "Checkout is down" {
+		t.Fatalf("Expected the description to be imported, Got: %q", got)
+	}
+
+	data := schema.TestResourceDataRaw(t, dataSourceSeverity().Schema, map[string]interface{}{"slug": "SEV1"})
+	if diags := dataFireHydrantSeverity(context.TODO(), data, ac); diags.HasError() {
+		t.Fatalf("Received error reading severity data source: %+v", diags)
+	}
+
+	if got := data.Get("color"); got != "#ff0000" {
+		t.Fatalf("Expected #ff0000, Got: %q for color", got)
+	}
+}
+
+func TestAccSeveritiesDataSource(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testFireHydrantIsSetup(t) },
+		ProviderFactories: defaultProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testSeverityConfig(rName) + testSeveritiesDataSource,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.firehydrant_severities.all", "severities.#"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.firehydrant_severities.all", "severities.*", map[string]string{
+						"slug": strings.ToUpper(rName),
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testSeveritiesDataSource = `
+data "firehydrant_severities" "all" {
+	depends_on = [firehydrant_severity.terraform-acceptance-test-severity]
+}
+`
+
 const testSeverityConfigTemplate = `
 resource "firehydrant_severity" "terraform-acceptance-test-severity" {
 	slug = "%s"
 }
 `
 
+const testSeverityWithTypeConfigTemplate = `
+resource "firehydrant_severity" "terraform-acceptance-test-severity" {
+	slug  = "%s"
+	type  = "maintenance"
+	color = "#0000ff"
+}
+`
+
+func testSeverityWithTypeConfig(rName string) string {
+	return fmt.Sprintf(testSeverityWithTypeConfigTemplate, strings.ToUpper(rName))
+}
+
 func testSeverityConfig(rName string) string {
 	return fmt.Sprintf(testSeverityConfigTemplate, strings.ToUpper(rName))
 }
@@ -105,7 +201,7 @@ func testSeverityDoesNotExist(resourceName string) resource.TestCheckFunc {
 		// 	return fmt.Errorf("The severity existed, when it should not")
 		// }
 
-		// if _, isNotFound := err.(firehydrant.NotFound); !isNotFound {
+		// if !firehydrant.IsNotFound(err) {
 		// 	return err
 		// }
 
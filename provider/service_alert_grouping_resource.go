@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceServiceAlertGrouping() *schema.Resource {
+	return &schema.Resource{
+		Description:   "FireHydrant service alert grouping treats alerts for a service that arrive close together and share the same keys as one alert.",
+		CreateContext: createResourceFireHydrantServiceAlertGrouping,
+		UpdateContext: updateResourceFireHydrantServiceAlertGrouping,
+		ReadContext:   readResourceFireHydrantServiceAlertGrouping,
+		DeleteContext: deleteResourceFireHydrantServiceAlertGrouping,
+		Importer: &schema.ResourceImporter{
+			StateContext: importParentID("service_id"),
+		},
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"window": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateAlertGroupingWindow,
+				DiffSuppressFunc: suppressEquivalentDurationDiff,
+				Description:      "How long after the first alert later alerts are grouped with it, in whole seconds, such as \"5m\" or \"90s\".",
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The alert attributes that have to match for alerts to be grouped, such as summary.",
+			},
+		},
+	}
+}
+
+// A service has at most one alert grouping, so these use the service's ID as their own
+
+func readResourceFireHydrantServiceAlertGrouping(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	r, err := ac.Services().GetAlertGrouping(ctx, d.Id())
+	if firehydrant.IsNotFound(err) {
+		// Alert grouping was turned off outside of Terraform
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := convertServiceAlertGroupingToState(r, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func createResourceFireHydrantServiceAlertGrouping(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	serviceID := d.Get("service_id").(string)
+
+	r, err := serviceAlertGroupingFromState(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resource, err := ac.Services().UpdateAlertGrouping(ctx, serviceID, r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(serviceID)
+
+	if err := convertServiceAlertGroupingToState(resource, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func updateResourceFireHydrantServiceAlertGrouping(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r, err := serviceAlertGroupingFromState(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = ac.Services().UpdateAlertGrouping(ctx, d.Id(), r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func deleteResourceFireHydrantServiceAlertGrouping(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	err := ac.Services().DeleteAlertGrouping(ctx, d.Id())
+	if err != nil && !firehydrant.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return diag.Diagnostics{}
+}
+
+// validateAlertGroupingWindow ensures a window is a positive number of whole seconds, since that is
+// what FireHydrant stores
+func validateAlertGroupingWindow(v interface{}, k string) ([]string, []error) {
+	if warnings, errs := validateDuration(v, k); len(errs) > 0 {
+		return warnings, errs
+	}
+
+	window, _ := time.ParseDuration(v.(string))
+	if window <= 0 {
+		return nil, []error{fmt.Errorf("expected %s to be longer than 0s, got %q", k, v)}
+	}
+
+	if window%time.Second != 0 {
+		return nil, []error{fmt.Errorf("expected %s to be a whole number of seconds, got %q", k, v)}
+	}
+
+	return nil, nil
+}
+
+// suppressEquivalentDurationDiff ignores a duration being written differently, such as "5m" being
+// read back from FireHydrant as "5m0s"
+func suppressEquivalentDurationDiff(k, old, new string, d *schema.ResourceData) bool {
+	oldDuration, err := time.ParseDuration(old)
+	if err != nil {
+		return false
+	}
+
+	newDuration, err := time.ParseDuration(new)
+	if err != nil {
+		return false
+	}
+
+	return oldDuration == newDuration
+}
+
+func serviceAlertGroupingFromState(d *schema.ResourceData) (firehydrant.ServiceAlertGrouping, error) {
+	window, err := time.ParseDuration(d.Get("window").(string))
+	if err != nil {
+		return firehydrant.ServiceAlertGrouping{}, err
+	}
+
+	keys := []string{}
+	for _, key := range d.Get("keys").([]interface{}) {
+		keys = append(keys, key.(string))
+	}
+
+	return firehydrant.ServiceAlertGrouping{
+		WindowSeconds: int(window / time.Second),
+		Keys:          keys,
+	}, nil
+}
+
+func convertServiceAlertGroupingToState(grouping *firehydrant.ServiceAlertGrouping, d *schema.ResourceData) error {
+	attributes := map[string]interface{}{
+		"window": (time.Duration(grouping.WindowSeconds) * time.Second).String(),
+		"keys":   grouping.Keys,
+	}
+
+	return setAttributesFromMap(d, attributes)
+}
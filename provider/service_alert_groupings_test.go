@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestCreateServiceAlertGrouping(t *testing.T) {
+	var updated firehydrant.ServiceAlertGrouping
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPut || req.URL.Path != "/services/test-service-id/alert_grouping" {
+			t.Errorf("Unexpected request %s %s", req.Method, req.URL.Path)
+		}
+
+		if err := json.NewDecoder(req.Body).Decode(&updated); err != nil {
+			t.Errorf("Could not decode request: %s", err)
+		}
+		json.NewEncoder(w).Encode(updated)
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceServiceAlertGrouping().Schema, map[string]interface{}{
+		"service_id": "test-service-id",
+		"window":     "5m",
+		"keys":       []interface{}{"summary", "labels.region"},
+	})
+
+	if diags := createResourceFireHydrantServiceAlertGrouping(context.TODO(), d, ac); diags.HasError() {
+		t.Fatalf("Received error creating alert grouping: %+v", diags)
+	}
+
+	expected := firehydrant.ServiceAlertGrouping{WindowSeconds: 300, Keys: []string{"summary", "labels.region"}}
+	if !reflect.DeepEqual(expected, updated) {
+		t.Fatalf("Expected %+v, Got: %+v for the alert grouping sent", expected, updated)
+	}
+
+	if d.Id() != "test-service-id" {
+		t.Fatalf("Expected the service's ID to be used, Got: %s", d.Id())
+	}
+
+	if !suppressEquivalentDurationDiff("window", d.Get("window").(string), "5m", d) {
+		t.Fatalf("Expected %q read back from FireHydrant to match 5m", d.Get("window"))
+	}
+}
+
+func TestValidateAlertGroupingWindow(t *testing.T) {
+	for _, window := range []string{"90s", "5m", "1h30m"} {
+		if _, errs := validateAlertGroupingWindow(window, "window"); len(errs) != 0 {
+			t.Fatalf("Expected %q to be a valid window, Got: %v", window, errs)
+		}
+	}
+
+	for _, window := range []string{"5 minutes", "0s", "-1m", "1500ms"} {
+		if _, errs := validateAlertGroupingWindow(window, "window"); len(errs) != 1 {
+			t.Fatalf("Expected %q to be an invalid window, Got: %v", window, errs)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // Singular services data source
@@ -30,6 +31,32 @@ func dataSourceService() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"slug": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The service's operational status, such as operational, degraded, or maintenance, as of the last refresh. Empty when FireHydrant does not report one.",
+			},
+			"functionalities": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The functionalities this service is attached to.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -46,6 +73,13 @@ func dataSourceServices() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"labels_match": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(firehydrant.LabelsMatchAll),
+				ValidateFunc: validation.StringInSlice([]string{string(firehydrant.LabelsMatchAll), string(firehydrant.LabelsMatchAny)}, false),
+				Description:  "Whether services must have all of the given labels or any one of them.",
+			},
 			"services": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -67,8 +101,23 @@ func dataSourceServices() *schema.Resource {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
+						"slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"managed_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The integration that keeps this service in sync, such as PagerDuty. Empty for services that are not managed by an integration.",
+						},
 					},
 				},
+				Description: "Every service matching the query and labels, across all pages of results.",
 			},
 		},
 	}
@@ -83,11 +132,22 @@ func dataFireHydrantService(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 
+	functionalities := make([]interface{}, len(r.Functionalities))
+	for index, functionality := range r.Functionalities {
+		functionalities[index] = map[string]interface{}{
+			"id":   functionality.ID,
+			"name": functionality.Name,
+		}
+	}
+
 	var ds diag.Diagnostics
 	svc := map[string]interface{}{
-		"name":         r.Name,
-		"description":  r.Description,
-		"service_tier": r.ServiceTier,
+		"name":            r.Name,
+		"description":     r.Description,
+		"service_tier":    r.ServiceTier,
+		"slug":            r.Slug,
+		"status":          r.Status,
+		"functionalities": functionalities,
 	}
 
 	for key, val := range svc {
@@ -116,6 +176,7 @@ func dataFireHydrantServices(ctx context.Context, d *schema.ResourceData, m inte
 	r, err := ac.Services().List(ctx, &firehydrant.ServiceQuery{
 		Query:          query,
 		LabelsSelector: ls,
+		LabelsMatch:    firehydrant.LabelsMatch(d.Get("labels_match").(string)),
 	})
 	if err != nil {
 		return diag.FromErr(err)
@@ -129,6 +190,9 @@ func dataFireHydrantServices(ctx context.Context, d *schema.ResourceData, m inte
 			"name":         svc.Name,
 			"description":  svc.Description,
 			"service_tier": svc.ServiceTier,
+			"slug":         svc.Slug,
+			"labels":       svc.Labels,
+			"managed_by":   svc.ManagedBy,
 		}
 		services = append(services, values)
 	}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccServiceDependencies(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testFireHydrantIsSetup(t) },
+		ProviderFactories: defaultProviderFactories(),
+		CheckDestroy:      testServiceDependencyDoesNotExist("firehydrant_service_dependency.terraform-acceptance-test-dependency"),
+		Steps: []resource.TestStep{
+			{
+				Config: testServiceDependencyConfig(rName, "first notes"),
+				Check: resource.ComposeTestCheckFunc(
+					testServiceDependencyExists("firehydrant_service_dependency.terraform-acceptance-test-dependency"),
+					resource.TestCheckResourceAttrPair("firehydrant_service_dependency.terraform-acceptance-test-dependency", "service_id", "firehydrant_service.service", "id"),
+					resource.TestCheckResourceAttrPair("firehydrant_service_dependency.terraform-acceptance-test-dependency", "connected_service_id", "firehydrant_service.connected", "id"),
+					resource.TestCheckResourceAttr("firehydrant_service_dependency.terraform-acceptance-test-dependency", "notes", "first notes"),
+				),
+			},
+			{
+				Config: testServiceDependencyConfig(rName, "updated notes"),
+				Check: resource.ComposeTestCheckFunc(
+					testServiceDependencyExists("firehydrant_service_dependency.terraform-acceptance-test-dependency"),
+					resource.TestCheckResourceAttr("firehydrant_service_dependency.terraform-acceptance-test-dependency", "notes", "updated notes"),
+				),
+			},
+		},
+	})
+}
+
+const testServiceDependencyConfigTemplate = `
+resource "firehydrant_service" "service" {
+	name = "%s"
+}
+
+resource "firehydrant_service" "connected" {
+	name = "%s connected"
+}
+
+resource "firehydrant_service_dependency" "terraform-acceptance-test-dependency" {
+	service_id           = firehydrant_service.service.id
+	connected_service_id = firehydrant_service.connected.id
+	notes                = "%s"
+}
+`
+
+func testServiceDependencyConfig(rName, notes string) string {
+	return fmt.Sprintf(testServiceDependencyConfigTemplate, rName, rName, notes)
+}
+
+func testServiceDependencyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		dependency, err := c.ServiceDependencies().Get(context.TODO(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if expected, got := rs.Primary.Attributes["notes"], dependency.Notes; expected != got {
+			return fmt.Errorf("Expected notes %s, got %s", expected, got)
+		}
+
+		return nil
+	}
+}
+
+func testServiceDependencyDoesNotExist(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return nil
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		dependency, err := c.ServiceDependencies().Get(context.TODO(), rs.Primary.ID)
+		if dependency != nil {
+			return fmt.Errorf("The service dependency existed, when it should not")
+		}
+
+		if !firehydrant.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}
+}
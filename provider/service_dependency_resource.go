@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceServiceDependency() *schema.Resource {
+	return &schema.Resource{
+		Description:   "FireHydrant service dependencies model one service depending on another.",
+		CreateContext: createResourceFireHydrantServiceDependency,
+		UpdateContext: updateResourceFireHydrantServiceDependency,
+		ReadContext:   readResourceFireHydrantServiceDependency,
+		DeleteContext: deleteResourceFireHydrantServiceDependency,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"connected_service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"notes": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func readResourceFireHydrantServiceDependency(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	r, err := ac.ServiceDependencies().Get(ctx, d.Id())
+	if firehydrant.IsNotFound(err) {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := convertServiceDependencyToState(r, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func createResourceFireHydrantServiceDependency(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r := firehydrant.CreateServiceDependencyRequest{
+		ServiceID:          d.Get("service_id").(string),
+		ConnectedServiceID: d.Get("connected_service_id").(string),
+		Notes:              d.Get("notes").(string),
+	}
+
+	resource, err := ac.ServiceDependencies().Create(ctx, r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.ID)
+
+	if err := convertServiceDependencyToState(resource, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func updateResourceFireHydrantServiceDependency(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r := firehydrant.UpdateServiceDependencyRequest{
+		Notes: d.Get("notes").(string),
+	}
+
+	_, err := ac.ServiceDependencies().Update(ctx, d.Id(), r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func deleteResourceFireHydrantServiceDependency(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	err := ac.ServiceDependencies().Delete(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return diag.Diagnostics{}
+}
+
+func convertServiceDependencyToState(dependency *firehydrant.ServiceDependencyResponse, d *schema.ResourceData) error {
+	serviceID, connectedServiceID := dependency.Service.ID, dependency.ConnectedService.ID
+
+	// FireHydrant may hand the dependency back from the perspective of either service, so
+	// keep the configured direction when the response is simply the reverse of it
+	if serviceID == d.Get("connected_service_id").(string) && connectedServiceID == d.Get("service_id").(string) {
+		serviceID, connectedServiceID = connectedServiceID, serviceID
+	}
+
+	attributes := map[string]interface{}{
+		"service_id":           serviceID,
+		"connected_service_id": connectedServiceID,
+		"notes":                dependency.Notes,
+	}
+
+	return setAttributesFromMap(d, attributes)
+}
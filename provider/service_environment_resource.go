@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceServiceEnvironment() *schema.Resource {
+	return &schema.Resource{
+		Description:   "FireHydrant service environments record which environments a service runs in, which scopes the impact of incidents involving it.",
+		CreateContext: createResourceFireHydrantServiceEnvironment,
+		UpdateContext: updateResourceFireHydrantServiceEnvironment,
+		ReadContext:   readResourceFireHydrantServiceEnvironment,
+		DeleteContext: deleteResourceFireHydrantServiceEnvironment,
+		Importer: &schema.ResourceImporter{
+			StateContext: importParentID("service_id"),
+		},
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"environment_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the environments the service runs in. Only the environments listed here are managed; any others the service was linked to outside of Terraform are left alone.",
+			},
+		},
+	}
+}
+
+// A service's environments are managed together, so these use the service's ID as their own
+
+func readResourceFireHydrantServiceEnvironment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	r, err := ac.Services().Get(ctx, d.Id())
+	if firehydrant.IsNotFound(err) {
+		// The environments went away along with their service
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("environment_ids", managedServiceEnvironments(d, r.Environments)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func createResourceFireHydrantServiceEnvironment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	serviceID := d.Get("service_id").(string)
+
+	for _, environmentID := range convertStringSet(d.Get("environment_ids").(*schema.Set)) {
+		if err := ac.Services().AddEnvironment(ctx, serviceID, environmentID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(serviceID)
+
+	return readResourceFireHydrantServiceEnvironment(ctx, d, m)
+}
+
+func updateResourceFireHydrantServiceEnvironment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	o, n := d.GetChange("environment_ids")
+	oldEnvironments, newEnvironments := o.(*schema.Set), n.(*schema.Set)
+
+	for _, environmentID := range convertStringSet(oldEnvironments.Difference(newEnvironments)) {
+		// Environments that were deleted are already gone from the service
+		if err := ac.Services().RemoveEnvironment(ctx, d.Id(), environmentID); err != nil && !firehydrant.IsNotFound(err) {
+			return diag.FromErr(err)
+		}
+	}
+
+	for _, environmentID := range convertStringSet(newEnvironments.Difference(oldEnvironments)) {
+		if err := ac.Services().AddEnvironment(ctx, d.Id(), environmentID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diag.Diagnostics{}
+}
+
+// deleteResourceFireHydrantServiceEnvironment only unlinks the environments Terraform manages
+func deleteResourceFireHydrantServiceEnvironment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	for _, environmentID := range convertStringSet(d.Get("environment_ids").(*schema.Set)) {
+		if err := ac.Services().RemoveEnvironment(ctx, d.Id(), environmentID); err != nil && !firehydrant.IsNotFound(err) {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return diag.Diagnostics{}
+}
+
+// managedServiceEnvironments keeps only the environments already in the configuration or state, so that
+// environments linked to the service outside of Terraform never show up as drift
+func managedServiceEnvironments(d *schema.ResourceData, environments []firehydrant.ServiceEnvironment) []string {
+	managed := map[string]bool{}
+	for _, environmentID := range convertStringSet(d.Get("environment_ids").(*schema.Set)) {
+		managed[environmentID] = true
+	}
+
+	environmentIDs := []string{}
+	for _, environment := range environments {
+		if managed[environment.ID] {
+			environmentIDs = append(environmentIDs, environment.ID)
+		}
+	}
+
+	return environmentIDs
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestServiceEnvironmentLeavesManualLinksAlone(t *testing.T) {
+	var removed []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+			// The staging environment was linked to the service by hand in FireHydrant
+			w.Write([]byte(`{"id": "test-service-id", "environments": [
+				{"id": "production-id", "name": "Production"},
+				{"id": "staging-id", "name": "Staging"}
+			]}`))
+		case http.MethodDelete:
+			removed = append(removed, req.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceServiceEnvironment().Schema, map[string]interface{}{
+		"service_id":      "test-service-id",
+		"environment_ids": []interface{}{"production-id", "deleted-id"},
+	})
+	d.SetId("test-service-id")
+
+	if diags := readResourceFireHydrantServiceEnvironment(context.TODO(), d, ac); diags.HasError() {
+		t.Fatalf("Received error reading service environments: %+v", diags)
+	}
+
+	// The deleted environment drops out, and the manually linked one is never picked up
+	if got := convertStringSet(d.Get("environment_ids").(*schema.Set)); !reflect.DeepEqual([]string{"production-id"}, got) {
+		t.Fatalf("Expected only production-id to be managed, Got: %+v", got)
+	}
+
+	if diags := deleteResourceFireHydrantServiceEnvironment(context.TODO(), d, ac); diags.HasError() {
+		t.Fatalf("Received error deleting service environments: %+v", diags)
+	}
+
+	sort.Strings(removed)
+	if expected := []string{"/services/test-service-id/environments/production-id"}; !reflect.DeepEqual(expected, removed) {
+		t.Fatalf("Expected %+v, Got: %+v for the environments removed", expected, removed)
+	}
+}
@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceServiceLink() *schema.Resource {
+	return &schema.Resource{
+		Description:   "FireHydrant service links are named links shown on a service. Each link is managed on its own, so links added to the same service by other tools are left alone.",
+		CreateContext: createResourceFireHydrantServiceLink,
+		UpdateContext: updateResourceFireHydrantServiceLink,
+		ReadContext:   readResourceFireHydrantServiceLink,
+		DeleteContext: deleteResourceFireHydrantServiceLink,
+		Importer: &schema.ResourceImporter{
+			StateContext: importScopedResource("service_id"),
+		},
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"href_url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateServiceLinkURL,
+				Description:  "The URL the link goes to. `{{slug}}` is replaced with the service's slug, such as `https://grafana.example.com/d/{{slug}}`, so that a link can be templated for a service whose slug is not known yet.",
+			},
+		},
+	}
+}
+
+// serviceLinkSlugToken is replaced in href_url with the slug of the link's service
+const serviceLinkSlugToken = "{{slug}}"
+
+// Links can only be changed by replacing every link on a service, so each of these reads the
+// service's current links and writes them back with only this resource's link changed
+
+func readResourceFireHydrantServiceLink(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	r, err := ac.Services().Get(ctx, d.Get("service_id").(string))
+	if firehydrant.IsNotFound(err) {
+		// The link went away along with its service
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	link := findServiceLink(r.Links, func(l firehydrant.ServiceLink) bool { return l.ID == d.Id() })
+	if link == nil {
+		// The link was removed outside of Terraform
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+
+	// FireHydrant only has the URL the template expanded to, so the template is kept in state for as
+	// long as it still expands to the link's URL
+	hrefURL := link.HrefURL
+	if configured := d.Get("href_url").(string); expandServiceLinkURL(configured, r) == link.HrefURL {
+		hrefURL = configured
+	}
+
+	attributes := map[string]interface{}{
+		"name":     link.Name,
+		"href_url": hrefURL,
+	}
+	if err := setAttributesFromMap(d, attributes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func createResourceFireHydrantServiceLink(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	serviceID := d.Get("service_id").(string)
+	name := d.Get("name").(string)
+
+	svc, err := ac.Services().Get(ctx, serviceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	byName := func(l firehydrant.ServiceLink) bool { return l.Name == name }
+	if existing := findServiceLink(svc.Links, byName); existing != nil {
+		return diag.FromErr(fmt.Errorf("service %s already has a link named %q, import it with the ID %s:%s to manage it", serviceID, name, serviceID, existing.ID))
+	}
+
+	links := append(svc.Links, firehydrant.ServiceLink{
+		Name:    name,
+		HrefURL: expandServiceLinkURL(d.Get("href_url").(string), svc),
+	})
+
+	resource, err := ac.Services().UpdateLinks(ctx, serviceID, firehydrant.UpdateServiceLinksRequest{Links: links})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	link := findServiceLink(resource.Links, byName)
+	if link == nil {
+		return diag.FromErr(fmt.Errorf("FireHydrant did not return the link named %q after adding it to service %s", name, serviceID))
+	}
+
+	d.SetId(link.ID)
+
+	return diag.Diagnostics{}
+}
+
+func updateResourceFireHydrantServiceLink(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	serviceID := d.Get("service_id").(string)
+
+	svc, err := ac.Services().Get(ctx, serviceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	link := findServiceLink(svc.Links, func(l firehydrant.ServiceLink) bool { return l.ID == d.Id() })
+	if link == nil {
+		return diag.FromErr(fmt.Errorf("service %s no longer has the link %s", serviceID, d.Id()))
+	}
+	link.HrefURL = expandServiceLinkURL(d.Get("href_url").(string), svc)
+
+	_, err = ac.Services().UpdateLinks(ctx, serviceID, firehydrant.UpdateServiceLinksRequest{Links: svc.Links})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func deleteResourceFireHydrantServiceLink(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	serviceID := d.Get("service_id").(string)
+
+	svc, err := ac.Services().Get(ctx, serviceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	links := []firehydrant.ServiceLink{}
+	for _, link := range svc.Links {
+		if link.ID != d.Id() {
+			links = append(links, link)
+		}
+	}
+
+	if len(links) != len(svc.Links) {
+		_, err = ac.Services().UpdateLinks(ctx, serviceID, firehydrant.UpdateServiceLinksRequest{Links: links})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return diag.Diagnostics{}
+}
+
+// findServiceLink returns the first link that matches, pointing into links so that it can be changed in place
+func findServiceLink(links []firehydrant.ServiceLink, match func(firehydrant.ServiceLink) bool) *firehydrant.ServiceLink {
+	for i := range links {
+		if match(links[i]) {
+			return &links[i]
+		}
+	}
+
+	return nil
+}
+
+func expandServiceLinkURL(hrefURL string, service *firehydrant.ServiceResponse) string {
+	return strings.ReplaceAll(hrefURL, serviceLinkSlugToken, service.Slug)
+}
+
+// validateServiceLinkURL checks that href_url is a URL once any slug in it is filled in
+func validateServiceLinkURL(v interface{}, k string) ([]string, []error) {
+	hrefURL, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	return validation.IsURLWithHTTPorHTTPS(strings.ReplaceAll(hrefURL, serviceLinkSlugToken, "slug"), k)
+}
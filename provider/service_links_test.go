@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccServiceLinks(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testFireHydrantIsSetup(t) },
+		ProviderFactories: defaultProviderFactories(),
+		CheckDestroy:      testServiceLinkDoesNotExist("firehydrant_service_link.dashboard"),
+		Steps: []resource.TestStep{
+			{
+				Config: testServiceLinkConfig(rName, "https://example.com/dashboard"),
+				Check: resource.ComposeTestCheckFunc(
+					testServiceLinkExists("firehydrant_service_link.dashboard"),
+					testServiceLinkExists("firehydrant_service_link.runbook"),
+					resource.TestCheckResourceAttr("firehydrant_service_link.dashboard", "href_url", "https://example.com/dashboard"),
+				),
+			},
+			{
+				Config: testServiceLinkConfig(rName, "https://example.com/dashboard/v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testServiceLinkExists("firehydrant_service_link.dashboard"),
+					testServiceLinkExists("firehydrant_service_link.runbook"),
+					resource.TestCheckResourceAttr("firehydrant_service_link.dashboard", "href_url", "https://example.com/dashboard/v2"),
+				),
+			},
+			{
+				Config:      testServiceLinkConfig(rName, "https://example.com/dashboard/v2") + testServiceLinkDuplicate,
+				ExpectError: regexp.MustCompile(`already has a link named "Dashboard"`),
+			},
+		},
+	})
+}
+
+const testServiceLinkConfigTemplate = `
+resource "firehydrant_service" "service" {
+	name = "%s"
+}
+
+resource "firehydrant_service_link" "dashboard" {
+	service_id = firehydrant_service.service.id
+	name       = "Dashboard"
+	href_url   = "%s"
+}
+
+resource "firehydrant_service_link" "runbook" {
+	service_id = firehydrant_service.service.id
+	name       = "Runbook"
+	href_url   = "https://example.com/runbook"
+}
+`
+
+const testServiceLinkDuplicate = `
+resource "firehydrant_service_link" "duplicate" {
+	service_id = firehydrant_service.service.id
+	name       = "Dashboard"
+	href_url   = "https://example.com/duplicate"
+
+	depends_on = [firehydrant_service_link.dashboard]
+}
+`
+
+func testServiceLinkConfig(rName, hrefURL string) string {
+	return fmt.Sprintf(testServiceLinkConfigTemplate, rName, hrefURL)
+}
+
+func testServiceLinkExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		svc, err := c.Services().Get(context.TODO(), rs.Primary.Attributes["service_id"])
+		if err != nil {
+			return err
+		}
+
+		link := findServiceLink(svc.Links, func(l firehydrant.ServiceLink) bool { return l.ID == rs.Primary.ID })
+		if link == nil {
+			return fmt.Errorf("The service link %s does not exist", rs.Primary.ID)
+		}
+
+		if expected, got := rs.Primary.Attributes["href_url"], link.HrefURL; expected != got {
+			return fmt.Errorf("Expected href_url %s, got %s", expected, got)
+		}
+
+		return nil
+	}
+}
+
+func testServiceLinkDoesNotExist(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return nil
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		svc, err := c.Services().Get(context.TODO(), rs.Primary.Attributes["service_id"])
+		if firehydrant.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if findServiceLink(svc.Links, func(l firehydrant.ServiceLink) bool { return l.ID == rs.Primary.ID }) != nil {
+			return fmt.Errorf("The service link existed, when it should not")
+		}
+
+		return nil
+	}
+}
+
+func TestServiceLinkSlugTemplate(t *testing.T) {
+	links := []firehydrant.ServiceLink{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method == "PATCH" {
+			body := firehydrant.UpdateServiceLinksRequest{}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Errorf("Received error decoding the links: %s", err.Error())
+			}
+
+			links = body.Links
+			for index := range links {
+				links[index].ID = fmt.Sprintf("link-%d", index)
+			}
+		}
+
+		json.NewEncoder(w).Encode(firehydrant.ServiceResponse{ID: "service-id", Slug: "checkout", Links: links})
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceServiceLink().Schema, map[string]interface{}{
+		"service_id": "service-id",
+		"name":       "Dashboard",
+		"href_url":   "https://grafana.example.com/d/{{slug}}",
+	})
+
+	if diags := createResourceFireHydrantServiceLink(context.TODO(), d, ac); diags.HasError() {
+		t.Fatalf("Received error creating the link: %+v", diags)
+	}
+
+	if expected := "https://grafana.example.com/d/checkout"; links[0].HrefURL != expected {
+		t.Fatalf("Expected %s, Got: %s for the link's URL", expected, links[0].HrefURL)
+	}
+
+	if diags := readResourceFireHydrantServiceLink(context.TODO(), d, ac); diags.HasError() {
+		t.Fatalf("Received error reading the link: %+v", diags)
+	}
+
+	if got := d.Get("href_url").(string); got != "https://grafana.example.com/d/{{slug}}" {
+		t.Fatalf("Expected the template to be kept in state, Got: %s", got)
+	}
+
+	// A link changed outside of Terraform no longer matches the template and shows up as drift
+	links[0].HrefURL = "https://grafana.example.com/d/other"
+	if diags := readResourceFireHydrantServiceLink(context.TODO(), d, ac); diags.HasError() {
+		t.Fatalf("Received error reading the link: %+v", diags)
+	}
+
+	if got := d.Get("href_url").(string); got != "https://grafana.example.com/d/other" {
+		t.Fatalf("Expected the changed URL in state, Got: %s", got)
+	}
+}
+
+func TestValidateServiceLinkURL(t *testing.T) {
+	if _, errs := validateServiceLinkURL("https://grafana.example.com/d/{{slug}}?orgId=1", "href_url"); len(errs) != 0 {
+		t.Fatalf("Expected a templated URL to be valid, Got: %v", errs)
+	}
+
+	if _, errs := validateServiceLinkURL("{{slug}}", "href_url"); len(errs) == 0 {
+		t.Fatalf("Expected a template that is not a URL to be invalid")
+	}
+}
@@ -2,21 +2,40 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// The delete_behavior of services and environments, which decides whether removing one keeps its incident history
+const (
+	deleteBehaviorArchive = "archive"
+	deleteBehaviorDestroy = "destroy"
+)
+
+// serviceAPIAttributes are the attributes of a service that are stored in FireHydrant
+var serviceAPIAttributes = []string{"name", "description", "labels", "labels_all", "service_tier", "owner_id", "teams", "alert_on_add", "custom_fields", "external_resources"}
+
 func resourceService() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: createResourceFireHydrantService,
 		UpdateContext: updateResourceFireHydrantService,
 		ReadContext:   readResourceFireHydrantService,
 		DeleteContext: deleteResourceFireHydrantService,
+		CustomizeDiff: customizeDiffFireHydrantService,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importService,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -24,17 +43,112 @@ func resourceService() *schema.Resource {
 				Required: true,
 			},
 			"description": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressMarkdownWhitespaceDiff,
+				Description:      "Markdown describing the service. Differences only in trailing whitespace or line endings are ignored, since FireHydrant normalizes them.",
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSlug,
+				Description:  "The slug used in the service's URLs, which may only contain lowercase letters, numbers, hyphens, and underscores. FireHydrant generates one from the name when this is not set. Changing it replaces the service.",
 			},
 			"labels": {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"labels_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The labels sent to FireHydrant, which are the provider's default_labels merged with labels.",
+			},
 			"service_tier": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Default: 5,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(0, 5),
+				Description:  "The service tier of this resource, between 1 and 5, or 0 for a service without a tier, such as one being decommissioned.",
+			},
+			"owner_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the team that owns this service, which can differ from the teams that respond to it. An owner set outside of Terraform is kept until this is set.",
+			},
+			"teams": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Set:         hashServiceTeam,
+				Description: "The teams that respond to this service, which can differ from its owner. Their order does not matter. Only the teams listed here are managed; any others added to the service outside of Terraform are left alone.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"alert_on_add": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the service's responders are alerted when the service is added to an incident. FireHydrant's default is used until this is set.",
+			},
+			"custom_fields": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Values for your organization's custom fields, keyed by the field's slug. Values set outside of Terraform are removed.",
+			},
+			"external_resources": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Set:         hashExternalResource,
+				Description: "Objects in other tools linked to this service, such as PagerDuty services. Only the external resources listed here are managed; any others FireHydrant links to the service are left alone.",
+				Elem:        externalResourceResource(),
+			},
+			"delete_behavior": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      deleteBehaviorArchive,
+				ValidateFunc: validation.StringInSlice([]string{deleteBehaviorArchive, deleteBehaviorDestroy}, false),
+				Description:  "What happens to the service when it is removed from Terraform. archive keeps the service and its incident history in FireHydrant, while destroy permanently deletes both.",
+			},
+			// No default, so that services already in state don't show a change when upgrading
+			"adopt_existing": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "When creating the service, take over a service that already exists in FireHydrant with the same name, and the same slug when one is set, instead of creating another. The existing service is updated to match the configuration.",
+			},
+			"managed_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The integration that keeps this service in sync, such as PagerDuty. Empty for services that are not managed by an integration.",
+			},
+			"active_incidents": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the open incidents this service is involved in, as of the last refresh.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The service's operational status, such as operational, degraded, or maintenance, as of the last refresh. Empty when FireHydrant does not report one.",
+			},
+			"last_import": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the service was last imported from another tool, such as PagerDuty, as an RFC3339 time. Empty for services that were never imported.",
 			},
 		},
 	}
@@ -45,10 +159,10 @@ func readResourceFireHydrantService(ctx context.Context, d *schema.ResourceData,
 	serviceID := d.Id()
 
 	r, err := ac.Services().Get(ctx, serviceID)
-	if err != nil {
-		return diag.FromErr(err)
+	if firehydrant.IsNotFound(err) {
+		d.SetId("")
+		return diag.Diagnostics{}
 	}
-
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -57,7 +171,16 @@ func readResourceFireHydrantService(ctx context.Context, d *schema.ResourceData,
 	svc := map[string]interface{}{
 		"name":         r.Name,
 		"description":  r.Description,
+		"slug":         r.Slug,
 		"service_tier": r.ServiceTier,
+		"managed_by":   r.ManagedBy,
+		"owner_id":     serviceOwnerID(r),
+		"alert_on_add": r.AlertOnAdd,
+
+		"custom_fields":    r.CustomFields,
+		"status":           r.Status,
+		"active_incidents": r.ActiveIncidents,
+		"last_import":      serviceLastImport(r),
 	}
 
 	for key, val := range svc {
@@ -66,58 +189,374 @@ func readResourceFireHydrantService(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
-	if err := d.Set("labels", r.Labels); err != nil {
+	if err := setServiceLabels(d, r.Labels, defaultLabelsFromMeta(m)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("external_resources", managedExternalResources(d, r.ExternalResources)); err != nil {
 		return diag.FromErr(err)
 	}
 
+	if err := d.Set("teams", managedServiceTeams(d, r.Teams)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// delete_behavior only lives in Terraform, so imported services and ones in state from before it
+	// existed get the default here instead of showing a change
+	if d.Get("delete_behavior").(string) == "" {
+		if err := d.Set("delete_behavior", deleteBehaviorArchive); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return ds
 }
 
+// serviceLastImport returns when a service was last imported, or "" for services that were never imported
+func serviceLastImport(service *firehydrant.ServiceResponse) string {
+	if service.LastImport == nil {
+		return ""
+	}
+
+	return service.LastImport.CreatedAt.Format(time.RFC3339)
+}
+
 func createResourceFireHydrantService(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ac := m.(firehydrant.Client)
-	labels := convertStringMap(d.Get("labels").(map[string]interface{}))
+	defaultLabels := defaultLabelsFromMeta(m)
+	labels := mergeLabels(defaultLabels, convertStringMap(d.Get("labels").(map[string]interface{})))
 
 	r := firehydrant.CreateServiceRequest{
 		Name:        d.Get("name").(string),
 		Description: d.Get("description").(string),
-		ServiceTier: d.Get("service_tier").(int),
+		ServiceTier: firehydrant.Int(d.Get("service_tier").(int)),
 		Labels:      labels,
+		Slug:        d.Get("slug").(string),
+
+		CustomFields:      convertStringMap(d.Get("custom_fields").(map[string]interface{})),
+		ExternalResources: externalResourcesFromSet(d.Get("external_resources").(*schema.Set)),
 	}
 
+	if teams := serviceTeamsFromSet(d.Get("teams").(*schema.Set)); len(teams) > 0 {
+		r.Teams = teams
+	}
+
+	if ownerID := d.Get("owner_id").(string); ownerID != "" {
+		r.Owner = &firehydrant.ServiceTeam{ID: ownerID}
+	}
+
+	// GetOk can't tell false apart from unset, and an explicit false has to be sent
+	if alertOnAdd, ok := d.GetOkExists("alert_on_add"); ok {
+		r.AlertOnAdd = firehydrant.Bool(alertOnAdd.(bool))
+	}
+
+	if d.Get("adopt_existing").(bool) {
+		existing, err := findExistingService(ctx, ac, r.Name, r.Slug)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if existing != nil {
+			return adoptService(ctx, d, m, existing)
+		}
+	}
+
+	var ds diag.Diagnostics
+	start := time.Now()
 	newService, err := ac.Services().Create(ctx, r)
 	if err != nil {
-		return diag.FromErr(err)
+		orphan := findCreatedService(ctx, ac, r.Name, start, err)
+		if orphan == nil {
+			// A taken slug is explained better than FireHydrant's field error for it
+			if slugErr := slugTakenError(err, "service", r.Slug); slugErr != err {
+				return diag.FromErr(slugErr)
+			}
+			return fieldErrorDiagnostics(err, serviceRequestItems(r.Teams, r.ExternalResources))
+		}
+
+		newService = orphan
+		ds = append(ds, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Service was created even though the request to create it failed",
+			Detail:   fmt.Sprintf("Creating the service failed with %q, but FireHydrant had already created it as %s, so that service is used instead of creating another.", err, orphan.ID),
+		})
 	}
 
 	d.SetId(newService.ID)
 
 	attributes := map[string]interface{}{
-		"name":        newService.Name,
-		"description": newService.Description,
-		"labels":      newService.Labels,
+		"name":         newService.Name,
+		"description":  newService.Description,
+		"slug":         newService.Slug,
 		"service_tier": newService.ServiceTier,
+		"managed_by":   newService.ManagedBy,
+		"owner_id":     serviceOwnerID(newService),
+		"alert_on_add": newService.AlertOnAdd,
+
+		"custom_fields":      newService.CustomFields,
+		"status":             newService.Status,
+		"active_incidents":   newService.ActiveIncidents,
+		"last_import":        serviceLastImport(newService),
+		"external_resources": managedExternalResources(d, newService.ExternalResources),
+		"teams":              managedServiceTeams(d, newService.Teams),
 	}
 
 	if err := setAttributesFromMap(d, attributes); err != nil {
 		return diag.FromErr(err)
 	}
 
-	return diag.Diagnostics{}
+	if err := setServiceLabels(d, newService.Labels, defaultLabels); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ds
+}
+
+// serviceRequestItems names the teams and external resources sent for a service, for pointing out which
+// of them FireHydrant rejected
+func serviceRequestItems(teams []firehydrant.ServiceTeam, externalResources []firehydrant.ExternalResource) map[string][]string {
+	teamIDs := make([]string, len(teams))
+	for index, team := range teams {
+		teamIDs[index] = team.ID
+	}
+
+	return map[string][]string{
+		"teams":              teamIDs,
+		"external_resources": externalResourceIDs(externalResources),
+	}
+}
+
+// setServiceLabels stores every label FireHydrant has in labels_all, and the rest in labels. A label
+// that matches one of the provider's default_labels is left out of labels unless the service sets
+// the same key itself, so that the defaults do not show up as changes
+func setServiceLabels(d *schema.ResourceData, all map[string]string, defaultLabels map[string]string) error {
+	configured := d.Get("labels").(map[string]interface{})
+
+	labels := map[string]string{}
+	for key, value := range all {
+		if _, ok := configured[key]; !ok && defaultLabels[key] == value {
+			continue
+		}
+		labels[key] = value
+	}
+
+	if err := d.Set("labels", labels); err != nil {
+		return err
+	}
+
+	return d.Set("labels_all", all)
+}
+
+// mergeLabels returns the provider's default labels with a service's own labels on top, so that
+// the service wins when both set the same key
+func mergeLabels(defaultLabels map[string]string, labels map[string]string) map[string]string {
+	merged := map[string]string{}
+	for key, value := range defaultLabels {
+		merged[key] = value
+	}
+	for key, value := range labels {
+		merged[key] = value
+	}
+
+	return merged
+}
+
+// createdServiceClockSkew allows for FireHydrant's clock being behind ours when looking for a service
+// created by a request that failed
+const createdServiceClockSkew = time.Minute
+
+// uuidPattern matches the IDs FireHydrant gives services, telling them apart from slugs on import
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// importService accepts a service's slug as well as its ID, since the slug is what shows up in
+// FireHydrant's UI
+func importService(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if uuidPattern.MatchString(d.Id()) {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	service, err := findServiceBySlug(ctx, m.(firehydrant.Client), d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(service.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// findServiceBySlug resolves a slug using the service search, which also matches names and partial slugs
+func findServiceBySlug(ctx context.Context, ac firehydrant.Client, slug string) (*firehydrant.ServiceResponse, error) {
+	services, err := ac.Services().List(ctx, &firehydrant.ServiceQuery{Query: slug})
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []firehydrant.ServiceResponse
+	for _, service := range services.Services {
+		if strings.EqualFold(service.Slug, slug) {
+			matches = append(matches, service)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("could not find a service with the ID or slug %q", slug)
+	case 1:
+		return &matches[0], nil
+	}
+
+	found := make([]string, len(matches))
+	for index, service := range matches {
+		found[index] = fmt.Sprintf("%s (%s)", service.Name, service.ID)
+	}
+
+	return nil, fmt.Errorf("found %d services with the slug %q, import one of them by ID instead: %s", len(matches), slug, strings.Join(found, ", "))
+}
+
+// findCreatedService looks for a service that a failed create request made anyway, such as when the
+// request timed out after FireHydrant processed it. It only looks when FireHydrant never responded,
+// and only returns a service with the same name created since the request was sent
+func findCreatedService(ctx context.Context, ac firehydrant.Client, name string, sentAt time.Time, createErr error) *firehydrant.ServiceResponse {
+	var apiErr *firehydrant.APIError
+	if errors.As(createErr, &apiErr) {
+		return nil
+	}
+
+	services, err := ac.Services().List(ctx, &firehydrant.ServiceQuery{Query: name})
+	if err != nil {
+		return nil
+	}
+
+	var created []firehydrant.ServiceResponse
+	for _, service := range services.Services {
+		if service.Name == name && service.CreatedAt.After(sentAt.Add(-createdServiceClockSkew)) {
+			created = append(created, service)
+		}
+	}
+
+	// More than one match means the service can't be told apart from one created by someone else
+	if len(created) != 1 {
+		return nil
+	}
+
+	return &created[0]
+}
+
+// findExistingService looks for a service to adopt instead of creating one. It returns nil when there
+// is none, and an error when more than one service matches, since which to adopt can't be guessed
+func findExistingService(ctx context.Context, ac firehydrant.Client, name string, slug string) (*firehydrant.ServiceResponse, error) {
+	services, err := ac.Services().List(ctx, &firehydrant.ServiceQuery{Query: name})
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []firehydrant.ServiceResponse
+	for _, service := range services.Services {
+		if service.Name == name && (slug == "" || strings.EqualFold(service.Slug, slug)) {
+			matches = append(matches, service)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &matches[0], nil
+	}
+
+	found := make([]string, len(matches))
+	for index, service := range matches {
+		found[index] = fmt.Sprintf("%s (%s)", service.Slug, service.ID)
+	}
+
+	return nil, fmt.Errorf("found %d services named %q to adopt, set slug to pick one of them or import it instead: %s", len(matches), name, strings.Join(found, ", "))
+}
+
+// adoptService takes over an existing service in place of creating one, updating it to match the
+// configuration as if it had been imported and then applied
+func adoptService(ctx context.Context, d *schema.ResourceData, m interface{}, existing *firehydrant.ServiceResponse) diag.Diagnostics {
+	log.Printf("[INFO] Adopting existing FireHydrant service %q (%s) instead of creating it", existing.Name, existing.ID)
+
+	d.SetId(existing.ID)
+
+	ds := updateResourceFireHydrantService(ctx, d, m)
+	if ds.HasError() {
+		return ds
+	}
+
+	ds = append(ds, readResourceFireHydrantService(ctx, d, m)...)
+	if ds.HasError() {
+		return ds
+	}
+
+	return append(ds, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Adopted an existing service",
+		Detail:   fmt.Sprintf("A service named %q already existed in FireHydrant as %s, so it is managed by Terraform instead of creating another.", existing.Name, existing.ID),
+	})
 }
 
 func updateResourceFireHydrantService(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ac := m.(firehydrant.Client)
 
-	r := firehydrant.UpdateServiceRequest{
-		Name:        d.Get("name").(string),
-		Description: d.Get("description").(string),
-		ServiceTier: d.Get("service_tier").(int),
-		Labels:      convertStringMap(d.Get("labels").(map[string]interface{})),
+	// delete_behavior only lives in Terraform, so changing just that has nothing to send
+	if !d.HasChanges(serviceAPIAttributes...) {
+		return diag.Diagnostics{}
+	}
+
+	// Only what changed since the service was read is sent, so that changes made in FireHydrant to
+	// anything else in the meantime are not reverted
+	r := firehydrant.UpdateServiceRequest{}
+
+	if d.HasChange("name") {
+		r.Name = firehydrant.String(d.Get("name").(string))
+	}
+
+	if d.HasChange("description") {
+		r.Description = firehydrant.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("service_tier") {
+		r.ServiceTier = firehydrant.Int(d.Get("service_tier").(int))
+	}
+
+	// labels_all also changes when only the provider's default_labels do
+	if d.HasChanges("labels", "labels_all") {
+		labels := convertStringMap(d.Get("labels").(map[string]interface{}))
+		r.Labels = firehydrant.StringMap(mergeLabels(defaultLabelsFromMeta(m), labels))
+	}
+
+	if d.HasChange("owner_id") {
+		r.Owner = &firehydrant.ServiceTeam{ID: d.Get("owner_id").(string)}
+	}
+
+	if d.HasChange("teams") {
+		teams, err := serviceTeamsChanges(ctx, ac, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		r.Teams = &teams
+	}
+
+	if d.HasChange("alert_on_add") {
+		r.AlertOnAdd = firehydrant.Bool(d.Get("alert_on_add").(bool))
+	}
+
+	if d.HasChange("custom_fields") {
+		r.CustomFields = firehydrant.StringMap(convertStringMap(d.Get("custom_fields").(map[string]interface{})))
+	}
+
+	if d.HasChange("external_resources") {
+		r.ExternalResources = externalResourcesChanges(d)
 	}
 
 	_, err := ac.Services().Update(ctx, d.Id(), r)
 	if err != nil {
-		return diag.FromErr(err)
+		var teams []firehydrant.ServiceTeam
+		if r.Teams != nil {
+			teams = *r.Teams
+		}
+		return fieldErrorDiagnostics(err, serviceRequestItems(teams, r.ExternalResources))
 	}
 
 	return diag.Diagnostics{}
@@ -127,7 +566,12 @@ func deleteResourceFireHydrantService(ctx context.Context, d *schema.ResourceDat
 	ac := m.(firehydrant.Client)
 	serviceID := d.Id()
 
-	err := ac.Services().Delete(ctx, serviceID)
+	var err error
+	if d.Get("delete_behavior").(string) == deleteBehaviorDestroy {
+		err = ac.Services().Destroy(ctx, serviceID)
+	} else {
+		err = ac.Services().Delete(ctx, serviceID)
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -136,4 +580,282 @@ func deleteResourceFireHydrantService(ctx context.Context, d *schema.ResourceDat
 	return diag.Diagnostics{}
 }
 
+// customizeDiffFireHydrantService checks a service's plan against the provider's settings, so that mistakes
+// are caught before anything is applied
+func customizeDiffFireHydrantService(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if err := planServiceLabelsAll(d, defaultLabelsFromMeta(m)); err != nil {
+		return err
+	}
+
+	meta, ok := m.(*providerMeta)
+	if !ok {
+		return nil
+	}
+
+	if err := checkAllowedServiceLabels(d, meta.allowedLabels); err != nil {
+		return err
+	}
+
+	return checkManagedService(d, meta.protectManagedServices)
+}
+
+// planServiceLabelsAll shows the labels that will be sent to FireHydrant, including the provider's
+// default_labels, in the plan
+func planServiceLabelsAll(d *schema.ResourceDiff, defaultLabels map[string]string) error {
+	if !d.NewValueKnown("labels") {
+		return d.SetNewComputed("labels_all")
+	}
+
+	all := mergeLabels(defaultLabels, convertStringMap(d.Get("labels").(map[string]interface{})))
+	if reflect.DeepEqual(all, convertStringMap(d.Get("labels_all").(map[string]interface{}))) {
+		return nil
+	}
+
+	return d.SetNew("labels_all", all)
+}
+
+// checkManagedService refuses to plan changes to a service that an integration manages when the
+// provider is configured to protect those services, since the integration would fight over (or clobber) them
+func checkManagedService(d *schema.ResourceDiff, protectManagedServices bool) error {
+	if !protectManagedServices || d.Id() == "" {
+		return nil
+	}
+
+	managedBy := d.Get("managed_by").(string)
+	if managedBy == "" || managedBy == "terraform" {
+		return nil
+	}
+
+	for _, key := range serviceAPIAttributes {
+		if d.HasChange(key) {
+			return fmt.Errorf("service %s is managed by %s and %s is enabled, so it will not be changed. Make the change in %s instead, or remove the service from your configuration", d.Id(), managedBy, protectManagedServicesName, managedBy)
+		}
+	}
+
+	return nil
+}
+
+// checkAllowedServiceLabels fails the plan of a service with labels missing from the provider's allowed_labels.
+// Labels that are not known until apply are left for the next plan to check
+func checkAllowedServiceLabels(d *schema.ResourceDiff, allowedLabels map[string][]string) error {
+	if allowedLabels == nil || !d.NewValueKnown("labels") {
+		return nil
+	}
+
+	labels := map[string]string{}
+	for key, value := range d.Get("labels").(map[string]interface{}) {
+		if d.NewValueKnown("labels." + key) {
+			labels[key] = value.(string)
+		}
+	}
+
+	return validateLabels(labels, allowedLabels)
+}
+
+// validateLabels reports every label that is not allowed at once, so that they can all be fixed together
+func validateLabels(labels map[string]string, allowedLabels map[string][]string) error {
+	if allowedLabels == nil {
+		return nil
+	}
+
+	allowedKeys := make([]string, 0, len(allowedLabels))
+	for key := range allowedLabels {
+		allowedKeys = append(allowedKeys, key)
+	}
+	sort.Strings(allowedKeys)
+
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var problems []string
+	for _, key := range keys {
+		allowedValues, ok := allowedLabels[key]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("label %q is not allowed, use one of: %s", key, strings.Join(allowedKeys, ", ")))
+			continue
+		}
+
+		if len(allowedValues) > 0 && !stringInSlice(labels[key], allowedValues) {
+			sorted := append([]string{}, allowedValues...)
+			sort.Strings(sorted)
+			problems = append(problems, fmt.Sprintf("label %q can not be %q, use one of: %s", key, labels[key], strings.Join(sorted, ", ")))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("service labels do not match %s:\n  %s", allowedLabelsName, strings.Join(problems, "\n  "))
+	}
+
+	return nil
+}
+
+func stringInSlice(s string, slice []string) bool {
+	for _, v := range slice {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+func serviceOwnerID(service *firehydrant.ServiceResponse) string {
+	if service.Owner == nil {
+		return ""
+	}
+
+	return service.Owner.ID
+}
+
+// hashServiceTeam identifies a team by its ID alone, so that the name FireHydrant fills in does not make
+// a team look changed
+func hashServiceTeam(v interface{}) int {
+	return schema.HashString(v.(map[string]interface{})["id"])
+}
+
+func serviceTeamsFromSet(set *schema.Set) []firehydrant.ServiceTeam {
+	teams := []firehydrant.ServiceTeam{}
+	for _, team := range set.List() {
+		teams = append(teams, firehydrant.ServiceTeam{ID: team.(map[string]interface{})["id"].(string)})
+	}
+
+	return teams
+}
+
+// managedServiceTeams keeps only the teams already in the configuration or state, so that teams added to
+// the service outside of Terraform never show up as drift
+func managedServiceTeams(d *schema.ResourceData, teams []firehydrant.ServiceTeam) []interface{} {
+	managed := d.Get("teams").(*schema.Set)
+
+	values := []interface{}{}
+	for _, team := range teams {
+		value := map[string]interface{}{
+			"id":   team.ID,
+			"name": team.Name,
+		}
+
+		if managed.Contains(value) {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+// serviceTeamsChanges returns every team that should respond to a service after an update. FireHydrant
+// replaces a service's teams on update, so the teams it has now are read first to keep the ones added
+// outside of Terraform, while the teams taken out of the configuration are left out
+func serviceTeamsChanges(ctx context.Context, ac firehydrant.Client, d *schema.ResourceData) ([]firehydrant.ServiceTeam, error) {
+	service, err := ac.Services().Get(ctx, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	o, n := d.GetChange("teams")
+	removed := map[string]bool{}
+	for _, team := range serviceTeamsFromSet(o.(*schema.Set).Difference(n.(*schema.Set))) {
+		removed[team.ID] = true
+	}
+
+	teams := serviceTeamsFromSet(n.(*schema.Set))
+	configured := map[string]bool{}
+	for _, team := range teams {
+		configured[team.ID] = true
+	}
+
+	for _, team := range service.Teams {
+		if !removed[team.ID] && !configured[team.ID] {
+			teams = append(teams, firehydrant.ServiceTeam{ID: team.ID})
+		}
+	}
+
+	return teams, nil
+}
 
+// externalResourceResource is the schema of an external resource linked to a service or functionality
+func externalResourceResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"connection_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"connection_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The integration connection to link through, for organizations with more than one connection of the same type.",
+			},
+			"remote_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"remote_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func externalResourcesFromSet(set *schema.Set) []firehydrant.ExternalResource {
+	resources := []firehydrant.ExternalResource{}
+	for _, resource := range set.List() {
+		r := resource.(map[string]interface{})
+		resources = append(resources, firehydrant.ExternalResource{
+			ConnectionType: r["connection_type"].(string),
+			ConnectionID:   r["connection_id"].(string),
+			RemoteID:       r["remote_id"].(string),
+		})
+	}
+
+	return resources
+}
+
+// externalResourcesChanges adds the external resources added to the configuration and removes the ones
+// taken out of it, leaving every other external resource alone
+func externalResourcesChanges(d *schema.ResourceData) []firehydrant.ExternalResource {
+	o, n := d.GetChange("external_resources")
+	oldResources, newResources := o.(*schema.Set), n.(*schema.Set)
+
+	changes := externalResourcesFromSet(newResources.Difference(oldResources))
+	for _, removed := range externalResourcesFromSet(oldResources.Difference(newResources)) {
+		removed.Remove = true
+		changes = append(changes, removed)
+	}
+
+	return changes
+}
+
+// managedExternalResources keeps only the external resources already in the configuration or state, so
+// that ones FireHydrant links on its own, such as from a PagerDuty import, never show up as drift. It works
+// for any resource with an external_resources set, such as functionalities
+func managedExternalResources(d *schema.ResourceData, resources []firehydrant.ExternalResource) []interface{} {
+	managed := d.Get("external_resources").(*schema.Set)
+
+	values := []interface{}{}
+	for _, resource := range resources {
+		value := map[string]interface{}{
+			"connection_type": resource.ConnectionType,
+			"connection_id":   resource.ConnectionID,
+			"remote_id":       resource.RemoteID,
+			"remote_url":      resource.RemoteURL,
+		}
+
+		if managed.Contains(value) {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+// hashExternalResource identifies an external resource by what it links to, leaving out the
+// remote_url FireHydrant fills in
+func hashExternalResource(v interface{}) int {
+	r := v.(map[string]interface{})
+	return schema.HashString(fmt.Sprintf("%s:%s", r["connection_type"], r["remote_id"]))
+}
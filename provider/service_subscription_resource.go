@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceServiceSubscription() *schema.Resource {
+	return &schema.Resource{
+		Description:   "FireHydrant service subscriptions link a service to the Slack channel that incidents involving it are posted to.",
+		CreateContext: createResourceFireHydrantServiceSubscription,
+		UpdateContext: updateResourceFireHydrantServiceSubscription,
+		ReadContext:   readResourceFireHydrantServiceSubscription,
+		DeleteContext: deleteResourceFireHydrantServiceSubscription,
+		Importer: &schema.ResourceImporter{
+			StateContext: importParentID("service_id"),
+		},
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"channel_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the Slack channel, without the leading #.",
+			},
+			"auto_create": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether FireHydrant creates the channel in Slack when it does not exist yet.",
+			},
+			"channel_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the channel in Slack.",
+			},
+		},
+	}
+}
+
+// A service has at most one Slack channel, so these use the service's ID as their own
+
+func readResourceFireHydrantServiceSubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	r, err := ac.Services().GetSlackChannel(ctx, d.Id())
+	if firehydrant.IsNotFound(err) {
+		// The channel was unlinked outside of Terraform
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := convertServiceSubscriptionToState(r, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func createResourceFireHydrantServiceSubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	serviceID := d.Get("service_id").(string)
+
+	// A channel set by hand in FireHydrant is taken over rather than failing, but say so since it is replaced
+	var ds diag.Diagnostics
+	existing, err := ac.Services().GetSlackChannel(ctx, serviceID)
+	if err != nil && !firehydrant.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+	if err == nil && existing.ChannelName != d.Get("channel_name").(string) {
+		ds = append(ds, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Service was already linked to a Slack channel",
+			Detail:   fmt.Sprintf("Service %s was linked to #%s outside of Terraform. It is now linked to #%s instead.", serviceID, existing.ChannelName, d.Get("channel_name").(string)),
+		})
+	}
+
+	resource, err := ac.Services().UpdateSlackChannel(ctx, serviceID, serviceSubscriptionFromState(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(serviceID)
+
+	if err := convertServiceSubscriptionToState(resource, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ds
+}
+
+func updateResourceFireHydrantServiceSubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	resource, err := ac.Services().UpdateSlackChannel(ctx, d.Id(), serviceSubscriptionFromState(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Renaming the channel can link the service to a different channel in Slack
+	if err := d.Set("channel_id", resource.ChannelID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func deleteResourceFireHydrantServiceSubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	err := ac.Services().DeleteSlackChannel(ctx, d.Id())
+	if err != nil && !firehydrant.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return diag.Diagnostics{}
+}
+
+func serviceSubscriptionFromState(d *schema.ResourceData) firehydrant.ServiceSlackChannel {
+	return firehydrant.ServiceSlackChannel{
+		ChannelName: d.Get("channel_name").(string),
+		AutoCreate:  d.Get("auto_create").(bool),
+	}
+}
+
+func convertServiceSubscriptionToState(channel *firehydrant.ServiceSlackChannel, d *schema.ResourceData) error {
+	attributes := map[string]interface{}{
+		"channel_name": channel.ChannelName,
+		"auto_create":  channel.AutoCreate,
+		"channel_id":   channel.ChannelID,
+	}
+
+	return setAttributesFromMap(d, attributes)
+}
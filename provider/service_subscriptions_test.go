@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestCreateServiceSubscriptionTakesOverManualChannel(t *testing.T) {
+	var updated firehydrant.ServiceSlackChannel
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/services/test-service-id/slack_channel" {
+			t.Errorf("Unexpected request to %s", req.URL.Path)
+		}
+
+		switch req.Method {
+		case http.MethodGet:
+			// The channel was set by hand in FireHydrant
+			w.Write([]byte(`{"channel_id": "C0MANUAL", "channel_name": "manual-channel", "auto_create": false}`))
+		case http.MethodPut:
+			if err := json.NewDecoder(req.Body).Decode(&updated); err != nil {
+				t.Errorf("Could not decode request: %s", err)
+			}
+			w.Write([]byte(`{"channel_id": "C0NEW", "channel_name": "svc-api", "auto_create": true}`))
+		}
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceServiceSubscription().Schema, map[string]interface{}{
+		"service_id":   "test-service-id",
+		"channel_name": "svc-api",
+		"auto_create":  true,
+	})
+
+	diags := createResourceFireHydrantServiceSubscription(context.TODO(), d, ac)
+	if diags.HasError() {
+		t.Fatalf("Received error creating service subscription: %+v", diags)
+	}
+
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("Expected a warning about replacing the manually set channel, Got: %+v", diags)
+	}
+
+	if expected := (firehydrant.ServiceSlackChannel{ChannelName: "svc-api", AutoCreate: true}); updated != expected {
+		t.Fatalf("Expected %+v, Got: %+v for the updated channel", expected, updated)
+	}
+
+	if d.Id() != "test-service-id" || d.Get("channel_id").(string) != "C0NEW" {
+		t.Fatalf("Expected the service's ID and the new channel, Got: %s and %s", d.Id(), d.Get("channel_id"))
+	}
+}
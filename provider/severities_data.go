@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSeverities() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataFireHydrantSeverities,
+		Schema: map[string]*schema.Schema{
+			"severities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataFireHydrantSeverities(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r, err := ac.ListSeverities(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	severities := make([]interface{}, 0)
+	for _, severity := range r.Severities {
+		severities = append(severities, map[string]interface{}{
+			"slug":        severity.Slug,
+			"description": severity.Description,
+			"type":        severity.Type,
+			"color":       severity.Color,
+		})
+	}
+
+	if err := d.Set("severities", severities); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("does-not-matter")
+
+	return diag.Diagnostics{}
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSeverity() *schema.Resource {
+	return &schema.Resource{
+		Description: "Looks up a severity by its slug, such as SEV1.",
+		ReadContext: dataFireHydrantSeverity,
+		Schema: map[string]*schema.Schema{
+			"slug": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"color": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_priority": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataFireHydrantSeverity(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	slug := d.Get("slug").(string)
+
+	r, err := ac.GetSeverity(ctx, slug)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := convertSeverityToState(r, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(r.Slug)
+
+	return diag.Diagnostics{}
+}
@@ -15,17 +15,36 @@ func resourceSeverity() *schema.Resource {
 		ReadContext:   readResourceFireHydrantSeverity,
 		DeleteContext: deleteResourceFireHydrantSeverity,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importSeverity,
 		},
 		Schema: map[string]*schema.Schema{
 			"slug": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateSeveritySlug,
 			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The kind of event this severity is for, such as unexpected_downtime or maintenance. FireHydrant picks one when not set.",
+			},
+			"color": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The color FireHydrant shows this severity in, such as #ff0000.",
+			},
+			"default_priority": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateSeveritySlug,
+				Description:  "The slug of the priority incidents with this severity start with, such as P1.",
+			},
 		},
 	}
 }
@@ -33,27 +52,33 @@ func resourceSeverity() *schema.Resource {
 func readResourceFireHydrantSeverity(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ac := m.(firehydrant.Client)
 	r, err := ac.GetSeverity(ctx, d.Id())
+	if firehydrant.IsNotFound(err) {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err != nil {
+	if err := convertSeverityToState(r, d); err != nil {
 		return diag.FromErr(err)
 	}
 
-	var ds diag.Diagnostics
-	svc := map[string]string{
-		"slug":        r.Slug,
-		"description": r.Description,
-	}
+	return diag.Diagnostics{}
+}
 
-	for key, val := range svc {
-		if err := d.Set(key, val); err != nil {
-			return diag.FromErr(err)
-		}
+// importSeverity imports a severity by its slug. FireHydrant keeps slugs in upper case, so the slug it
+// returns is used as the ID in case the one given was written differently, such as sev1 for SEV1
+func importSeverity(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	ac := m.(firehydrant.Client)
+	r, err := ac.GetSeverity(ctx, d.Id())
+	if err != nil {
+		return nil, err
 	}
 
-	return ds
+	d.SetId(r.Slug)
+
+	return []*schema.ResourceData{d}, nil
 }
 
 func createResourceFireHydrantSeverity(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -63,6 +88,10 @@ func createResourceFireHydrantSeverity(ctx context.Context, d *schema.ResourceDa
 	r := firehydrant.CreateSeverityRequest{
 		Slug:        slug,
 		Description: description,
+		Type:        d.Get("type").(string),
+		Color:       d.Get("color").(string),
+
+		DefaultPriority: d.Get("default_priority").(string),
 	}
 
 	resource, err := ac.CreateSeverity(ctx, r)
@@ -72,7 +101,15 @@ func createResourceFireHydrantSeverity(ctx context.Context, d *schema.ResourceDa
 
 	d.SetId(resource.Slug)
 
-	if err := d.Set("description", resource.Description); err != nil {
+	attributes := map[string]interface{}{
+		"description": resource.Description,
+		"type":        resource.Type,
+		"color":       resource.Color,
+
+		"default_priority": resource.DefaultPriority,
+	}
+
+	if err := setAttributesFromMap(d, attributes); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -86,6 +123,12 @@ func updateResourceFireHydrantSeverity(ctx context.Context, d *schema.ResourceDa
 	r := firehydrant.UpdateSeverityRequest{
 		Slug:        id,
 		Description: description,
+		Type:        d.Get("type").(string),
+		Color:       d.Get("color").(string),
+	}
+
+	if d.HasChange("default_priority") {
+		r.DefaultPriority = firehydrant.String(d.Get("default_priority").(string))
 	}
 
 	_, err := ac.UpdateSeverity(ctx, id, r)
@@ -108,3 +151,16 @@ func deleteResourceFireHydrantSeverity(ctx context.Context, d *schema.ResourceDa
 	d.SetId("")
 	return diag.Diagnostics{}
 }
+
+func convertSeverityToState(severity *firehydrant.SeverityResponse, d *schema.ResourceData) error {
+	attributes := map[string]interface{}{
+		"slug":        severity.Slug,
+		"description": severity.Description,
+		"type":        severity.Type,
+		"color":       severity.Color,
+
+		"default_priority": severity.DefaultPriority,
+	}
+
+	return setAttributesFromMap(d, attributes)
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSignalRule() *schema.Resource {
+	return &schema.Resource{
+		Description:   "FireHydrant signal rules route alerts that match an expression to a team's escalation policy, schedule, or people.",
+		CreateContext: createResourceFireHydrantSignalRule,
+		UpdateContext: updateResourceFireHydrantSignalRule,
+		ReadContext:   readResourceFireHydrantSignalRule,
+		DeleteContext: deleteResourceFireHydrantSignalRule,
+		Importer: &schema.ResourceImporter{
+			StateContext: importScopedResource("team_id"),
+		},
+		Schema: map[string]*schema.Schema{
+			"team_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"expression": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressExpressionWhitespaceDiff,
+				Description:      "The expression alerts are matched against, stored exactly as written.",
+			},
+			"target_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"escalation_policy", "schedule", "user", "team"}, false),
+			},
+			"target_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"incident_type_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"create_incident_condition_when": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "unspecified",
+				ValidateFunc: validation.StringInSlice([]string{"unspecified", "always"}, false),
+				Description:  "Whether alerts matching this rule always open an incident.",
+			},
+			"position": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Where the rule is among the team's rules, starting from 1. Alerts are routed by the first rule they match, so rules with a lower position take precedence. New rules are added last until this is set.",
+			},
+		},
+	}
+}
+
+func readResourceFireHydrantSignalRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	r, err := ac.SignalRules().Get(ctx, d.Get("team_id").(string), d.Id())
+	if firehydrant.IsNotFound(err) {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := convertSignalRuleToState(r, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func createResourceFireHydrantSignalRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r := firehydrant.CreateSignalRuleRequest{
+		Name:       d.Get("name").(string),
+		Expression: d.Get("expression").(string),
+		Target: firehydrant.SignalRuleTarget{
+			Type: d.Get("target_type").(string),
+			ID:   d.Get("target_id").(string),
+		},
+		IncidentTypeID:              d.Get("incident_type_id").(string),
+		CreateIncidentConditionWhen: d.Get("create_incident_condition_when").(string),
+		Position:                    d.Get("position").(int),
+	}
+
+	resource, err := ac.SignalRules().Create(ctx, d.Get("team_id").(string), r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.ID)
+
+	if err := convertSignalRuleToState(resource, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func updateResourceFireHydrantSignalRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r := firehydrant.UpdateSignalRuleRequest{
+		Name:       d.Get("name").(string),
+		Expression: d.Get("expression").(string),
+		Target: firehydrant.SignalRuleTarget{
+			Type: d.Get("target_type").(string),
+			ID:   d.Get("target_id").(string),
+		},
+		IncidentTypeID:              d.Get("incident_type_id").(string),
+		CreateIncidentConditionWhen: d.Get("create_incident_condition_when").(string),
+	}
+
+	// Moving a rule shifts the team's other rules, so the position is only sent when it changes
+	if d.HasChange("position") {
+		r.Position = firehydrant.Int(d.Get("position").(int))
+	}
+
+	_, err := ac.SignalRules().Update(ctx, d.Get("team_id").(string), d.Id(), r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func deleteResourceFireHydrantSignalRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	err := ac.SignalRules().Delete(ctx, d.Get("team_id").(string), d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return diag.Diagnostics{}
+}
+
+func convertSignalRuleToState(rule *firehydrant.SignalRuleResponse, d *schema.ResourceData) error {
+	// The expression FireHydrant hands back is kept as-is so that changes made outside of Terraform
+	// show up as drift, while suppressExpressionWhitespaceDiff hides FireHydrant reformatting it
+	attributes := map[string]interface{}{
+		"name":                           rule.Name,
+		"expression":                     rule.Expression,
+		"target_type":                    rule.Target.Type,
+		"target_id":                      rule.Target.ID,
+		"incident_type_id":               rule.IncidentTypeID,
+		"create_incident_condition_when": rule.CreateIncidentConditionWhen,
+		"position":                       rule.Position,
+	}
+
+	return setAttributesFromMap(d, attributes)
+}
+
+// suppressExpressionWhitespaceDiff ignores differences in how an expression is spaced, such as
+// FireHydrant normalizing the expression it was given, while still showing any other change
+func suppressExpressionWhitespaceDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.Join(strings.Fields(old), " ") == strings.Join(strings.Fields(new), " ")
+}
@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSignalRules(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testFireHydrantIsSetup(t) },
+		ProviderFactories: defaultProviderFactories(),
+		CheckDestroy:      testSignalRuleDoesNotExist("firehydrant_signal_rule.terraform-acceptance-test-rule"),
+		Steps: []resource.TestStep{
+			{
+				Config: testSignalRuleConfig(rName, `signal.summary.contains('database')`),
+				Check: resource.ComposeTestCheckFunc(
+					testSignalRuleExists("firehydrant_signal_rule.terraform-acceptance-test-rule"),
+					resource.TestCheckResourceAttr("firehydrant_signal_rule.terraform-acceptance-test-rule", "name", rName),
+					resource.TestCheckResourceAttr("firehydrant_signal_rule.terraform-acceptance-test-rule", "expression", `signal.summary.contains('database')`),
+					resource.TestCheckResourceAttr("firehydrant_signal_rule.terraform-acceptance-test-rule", "target_type", "team"),
+					resource.TestCheckResourceAttrPair("firehydrant_signal_rule.terraform-acceptance-test-rule", "target_id", "firehydrant_team.team", "id"),
+				),
+			},
+			{
+				Config: testSignalRuleConfig(rName, `signal.summary.contains('cache')`),
+				Check: resource.ComposeTestCheckFunc(
+					testSignalRuleExists("firehydrant_signal_rule.terraform-acceptance-test-rule"),
+					resource.TestCheckResourceAttr("firehydrant_signal_rule.terraform-acceptance-test-rule", "expression", `signal.summary.contains('cache')`),
+				),
+			},
+		},
+	})
+}
+
+const testSignalRuleConfigTemplate = `
+resource "firehydrant_team" "team" {
+	name = "%s"
+}
+
+resource "firehydrant_signal_rule" "terraform-acceptance-test-rule" {
+	team_id     = firehydrant_team.team.id
+	name        = "%s"
+	expression  = "%s"
+	target_type = "team"
+	target_id   = firehydrant_team.team.id
+}
+`
+
+func testSignalRuleConfig(rName, expression string) string {
+	return fmt.Sprintf(testSignalRuleConfigTemplate, rName, rName, expression)
+}
+
+func TestSuppressExpressionWhitespaceDiff(t *testing.T) {
+	cases := []struct {
+		old, new string
+		suppress bool
+	}{
+		{old: "signal.level == 'ERROR'", new: "signal.level == 'ERROR'", suppress: true},
+		{old: "signal.level == 'ERROR'", new: "signal.level=='ERROR'", suppress: false},
+		{old: "signal.level == 'ERROR'", new: "  signal.level  ==\n'ERROR' ", suppress: true},
+		{old: "signal.level == 'ERROR'", new: "signal.level == 'WARN'", suppress: false},
+	}
+
+	for _, tc := range cases {
+		if got := suppressExpressionWhitespaceDiff("expression", tc.old, tc.new, nil); got != tc.suppress {
+			t.Errorf("suppressExpressionWhitespaceDiff(%q, %q) = %t, expected %t", tc.old, tc.new, got, tc.suppress)
+		}
+	}
+}
+
+func TestSignalRulePosition(t *testing.T) {
+	var updates []map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodPatch {
+			var updated map[string]interface{}
+			if err := json.NewDecoder(req.Body).Decode(&updated); err != nil {
+				t.Errorf("Could not decode request: %s", err)
+			}
+			updates = append(updates, updated)
+		}
+
+		// FireHydrant adds new rules last
+		w.Write([]byte(`{"id": "test-rule-id", "name": "rule", "expression": "true", "target": {"type": "team", "id": "test-team-id"}, "create_incident_condition_when": "unspecified", "position": 3}`))
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	config := map[string]interface{}{
+		"team_id":     "test-team-id",
+		"name":        "rule",
+		"expression":  "true",
+		"target_type": "team",
+		"target_id":   "test-team-id",
+	}
+
+	r := resourceSignalRule()
+	d := schema.TestResourceDataRaw(t, r.Schema, config)
+	if diags := createResourceFireHydrantSignalRule(context.TODO(), d, ac); diags.HasError() {
+		t.Fatalf("Received error creating signal rule: %+v", diags)
+	}
+
+	if got := d.Get("position").(int); got != 3 {
+		t.Fatalf("Expected the position FireHydrant gave the rule, Got: %d", got)
+	}
+
+	for _, position := range []interface{}{nil, 1} {
+		config["name"] = fmt.Sprintf("rule at %v", position)
+		if position != nil {
+			config["position"] = position
+		}
+
+		diff, err := r.Diff(context.TODO(), d.State(), terraform.NewResourceConfigRaw(config), ac)
+		if err != nil {
+			t.Fatalf("Received error planning the update: %s", err.Error())
+		}
+		if _, diags := r.Apply(context.TODO(), d.State(), diff, ac); diags.HasError() {
+			t.Fatalf("Received error updating signal rule: %+v", diags)
+		}
+	}
+
+	// Only moving the rule sends its position, so that rules shifted by others are left where they are
+	if _, ok := updates[0]["position"]; ok || len(updates) != 2 {
+		t.Fatalf("Expected the position to be left out of the first update, Got: %+v", updates)
+	}
+
+	if updates[1]["position"] != float64(1) {
+		t.Fatalf("Expected the rule to be moved to position 1, Got: %+v", updates[1])
+	}
+}
+
+func testSignalRuleExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		rule, err := c.SignalRules().Get(context.TODO(), rs.Primary.Attributes["team_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if expected, got := rs.Primary.Attributes["name"], rule.Name; expected != got {
+			return fmt.Errorf("Expected name %s, got %s", expected, got)
+		}
+
+		return nil
+	}
+}
+
+func testSignalRuleDoesNotExist(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return nil
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		rule, err := c.SignalRules().Get(context.TODO(), rs.Primary.Attributes["team_id"], rs.Primary.ID)
+		if rule != nil {
+			return fmt.Errorf("The signal rule existed, when it should not")
+		}
+
+		if !firehydrant.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}
+}
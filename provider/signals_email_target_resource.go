@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSignalsEmailTarget() *schema.Resource {
+	return &schema.Resource{
+		Description:   "FireHydrant signals email targets are email addresses that turn the emails sent to them into alerts for a team.",
+		CreateContext: createResourceFireHydrantSignalsEmailTarget,
+		UpdateContext: updateResourceFireHydrantSignalsEmailTarget,
+		ReadContext:   readResourceFireHydrantSignalsEmailTarget,
+		DeleteContext: deleteResourceFireHydrantSignalsEmailTarget,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"team_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the team that alerts from this address are routed to.",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The email address FireHydrant generated, which monitoring tools send alerts to.",
+			},
+		},
+	}
+}
+
+func readResourceFireHydrantSignalsEmailTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	r, err := ac.SignalsEmailTargets().Get(ctx, d.Id())
+	if firehydrant.IsNotFound(err) {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := convertSignalsEmailTargetToState(r, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func createResourceFireHydrantSignalsEmailTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r := firehydrant.CreateSignalsEmailTargetRequest{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		TeamID:      d.Get("team_id").(string),
+	}
+
+	resource, err := ac.SignalsEmailTargets().Create(ctx, r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.ID)
+
+	if err := convertSignalsEmailTargetToState(resource, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func updateResourceFireHydrantSignalsEmailTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r := firehydrant.UpdateSignalsEmailTargetRequest{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		TeamID:      d.Get("team_id").(string),
+	}
+
+	_, err := ac.SignalsEmailTargets().Update(ctx, d.Id(), r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func deleteResourceFireHydrantSignalsEmailTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	err := ac.SignalsEmailTargets().Delete(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return diag.Diagnostics{}
+}
+
+func convertSignalsEmailTargetToState(target *firehydrant.SignalsEmailTargetResponse, d *schema.ResourceData) error {
+	attributes := map[string]interface{}{
+		"name":        target.Name,
+		"description": target.Description,
+		"team_id":     target.TeamID,
+		"email":       target.Email,
+	}
+
+	return setAttributesFromMap(d, attributes)
+}
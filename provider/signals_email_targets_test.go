@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSignalsEmailTargets(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	rNameUpdated := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testFireHydrantIsSetup(t) },
+		ProviderFactories: defaultProviderFactories(),
+		CheckDestroy:      testSignalsEmailTargetDoesNotExist("firehydrant_signals_email_target.terraform-acceptance-test-email-target"),
+		Steps: []resource.TestStep{
+			{
+				Config: testSignalsEmailTargetConfig(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testSignalsEmailTargetExists("firehydrant_signals_email_target.terraform-acceptance-test-email-target"),
+					resource.TestCheckResourceAttr("firehydrant_signals_email_target.terraform-acceptance-test-email-target", "name", rName),
+					resource.TestMatchResourceAttr("firehydrant_signals_email_target.terraform-acceptance-test-email-target", "email", regexp.MustCompile(`^\S+@\S+$`)),
+				),
+			},
+			{
+				Config: testSignalsEmailTargetConfig(rName, rNameUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testSignalsEmailTargetExists("firehydrant_signals_email_target.terraform-acceptance-test-email-target"),
+					resource.TestCheckResourceAttr("firehydrant_signals_email_target.terraform-acceptance-test-email-target", "name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+const testSignalsEmailTargetConfigTemplate = `
+resource "firehydrant_team" "team" {
+	name = "%s"
+}
+
+resource "firehydrant_signals_email_target" "terraform-acceptance-test-email-target" {
+	name        = "%s"
+	description = "A signals email target created by the acceptance tests"
+	team_id     = firehydrant_team.team.id
+}
+`
+
+func testSignalsEmailTargetConfig(teamName, name string) string {
+	return fmt.Sprintf(testSignalsEmailTargetConfigTemplate, teamName, name)
+}
+
+func testSignalsEmailTargetExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		target, err := c.SignalsEmailTargets().Get(context.TODO(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if expected, got := rs.Primary.Attributes["email"], target.Email; expected != got {
+			return fmt.Errorf("Unexpected email. Expected: %s, got: %s", expected, got)
+		}
+
+		return nil
+	}
+}
+
+func testSignalsEmailTargetDoesNotExist(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return nil
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		target, err := c.SignalsEmailTargets().Get(context.TODO(), rs.Primary.ID)
+		if target != nil {
+			return fmt.Errorf("The signals email target existed, when it should not")
+		}
+
+		if !firehydrant.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}
+}
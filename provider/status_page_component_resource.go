@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceStatusPageComponent() *schema.Resource {
+	return &schema.Resource{
+		Description:   "FireHydrant status page components show the status of a service or functionality on a status page.",
+		CreateContext: createResourceFireHydrantStatusPageComponent,
+		UpdateContext: updateResourceFireHydrantStatusPageComponent,
+		ReadContext:   readResourceFireHydrantStatusPageComponent,
+		DeleteContext: deleteResourceFireHydrantStatusPageComponent,
+		Importer: &schema.ResourceImporter{
+			StateContext: importScopedResource("status_page_id"),
+		},
+		Schema: map[string]*schema.Schema{
+			"status_page_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"infrastructure_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"service", "functionality"}, false),
+			},
+			"infrastructure_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the service or functionality this component shows.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name shown on the status page.",
+			},
+			"group_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The group this component is shown under. Components without a group are shown on their own.",
+			},
+			"position": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Where this component is shown within its group, starting from 1. FireHydrant adds components to the end when not set.",
+			},
+		},
+	}
+}
+
+func readResourceFireHydrantStatusPageComponent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	r, err := ac.StatusPageComponents().Get(ctx, d.Get("status_page_id").(string), d.Id())
+	if firehydrant.IsNotFound(err) {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := convertStatusPageComponentToState(r, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func createResourceFireHydrantStatusPageComponent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r := firehydrant.CreateStatusPageComponentRequest{
+		Name:               d.Get("name").(string),
+		GroupName:          d.Get("group_name").(string),
+		Position:           d.Get("position").(int),
+		InfrastructureType: d.Get("infrastructure_type").(string),
+		InfrastructureID:   d.Get("infrastructure_id").(string),
+	}
+
+	resource, err := ac.StatusPageComponents().Create(ctx, d.Get("status_page_id").(string), r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.ID)
+
+	if err := convertStatusPageComponentToState(resource, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func updateResourceFireHydrantStatusPageComponent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r := firehydrant.UpdateStatusPageComponentRequest{
+		Name:      d.Get("name").(string),
+		GroupName: d.Get("group_name").(string),
+		Position:  d.Get("position").(int),
+	}
+
+	_, err := ac.StatusPageComponents().Update(ctx, d.Get("status_page_id").(string), d.Id(), r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func deleteResourceFireHydrantStatusPageComponent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	err := ac.StatusPageComponents().Delete(ctx, d.Get("status_page_id").(string), d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return diag.Diagnostics{}
+}
+
+func convertStatusPageComponentToState(component *firehydrant.StatusPageComponentResponse, d *schema.ResourceData) error {
+	attributes := map[string]interface{}{
+		"name":                component.Name,
+		"group_name":          component.GroupName,
+		"position":            component.Position,
+		"infrastructure_type": component.InfrastructureType,
+		"infrastructure_id":   component.InfrastructureID,
+	}
+
+	return setAttributesFromMap(d, attributes)
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// Status pages can't be created through the API, so these tests need the ID of an existing one
+func testStatusPageIsSetup(t *testing.T) {
+	testFireHydrantIsSetup(t)
+
+	if v := os.Getenv("FIREHYDRANT_STATUS_PAGE_ID"); v == "" {
+		t.Skip("FIREHYDRANT_STATUS_PAGE_ID must be set to test status page components")
+	}
+}
+
+func TestAccStatusPageComponents(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testStatusPageIsSetup(t) },
+		ProviderFactories: defaultProviderFactories(),
+		CheckDestroy:      testStatusPageComponentDoesNotExist("firehydrant_status_page_component.terraform-acceptance-test-component"),
+		Steps: []resource.TestStep{
+			{
+				Config: testStatusPageComponentConfig(rName, "Core"),
+				Check: resource.ComposeTestCheckFunc(
+					testStatusPageComponentExists("firehydrant_status_page_component.terraform-acceptance-test-component"),
+					resource.TestCheckResourceAttr("firehydrant_status_page_component.terraform-acceptance-test-component", "name", rName),
+					resource.TestCheckResourceAttr("firehydrant_status_page_component.terraform-acceptance-test-component", "group_name", "Core"),
+					resource.TestCheckResourceAttrPair("firehydrant_status_page_component.terraform-acceptance-test-component", "infrastructure_id", "firehydrant_service.service", "id"),
+				),
+			},
+			{
+				Config: testStatusPageComponentConfig(rName, "Edge"),
+				Check: resource.ComposeTestCheckFunc(
+					testStatusPageComponentExists("firehydrant_status_page_component.terraform-acceptance-test-component"),
+					resource.TestCheckResourceAttr("firehydrant_status_page_component.terraform-acceptance-test-component", "group_name", "Edge"),
+				),
+			},
+		},
+	})
+}
+
+const testStatusPageComponentConfigTemplate = `
+resource "firehydrant_service" "service" {
+	name = "%s"
+}
+
+resource "firehydrant_status_page_component" "terraform-acceptance-test-component" {
+	status_page_id      = "%s"
+	infrastructure_type = "service"
+	infrastructure_id   = firehydrant_service.service.id
+	name                = "%s"
+	group_name          = "%s"
+}
+`
+
+func testStatusPageComponentConfig(rName, groupName string) string {
+	return fmt.Sprintf(testStatusPageComponentConfigTemplate, rName, os.Getenv("FIREHYDRANT_STATUS_PAGE_ID"), rName, groupName)
+}
+
+func testStatusPageComponentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		component, err := c.StatusPageComponents().Get(context.TODO(), rs.Primary.Attributes["status_page_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if expected, got := rs.Primary.Attributes["group_name"], component.GroupName; expected != got {
+			return fmt.Errorf("Expected group_name %s, got %s", expected, got)
+		}
+
+		return nil
+	}
+}
+
+func testStatusPageComponentDoesNotExist(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return nil
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		component, err := c.StatusPageComponents().Get(context.TODO(), rs.Primary.Attributes["status_page_id"], rs.Primary.ID)
+		if component != nil {
+			return fmt.Errorf("The status page component existed, when it should not")
+		}
+
+		if !firehydrant.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}
+}
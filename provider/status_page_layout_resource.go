@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceStatusPageLayout() *schema.Resource {
+	return &schema.Resource{
+		Description:   "FireHydrant status page layouts arrange a status page's components into groups, in order.",
+		CreateContext: createResourceFireHydrantStatusPageLayout,
+		UpdateContext: updateResourceFireHydrantStatusPageLayout,
+		ReadContext:   readResourceFireHydrantStatusPageLayout,
+		DeleteContext: deleteResourceFireHydrantStatusPageLayout,
+		Importer: &schema.ResourceImporter{
+			StateContext: importParentID("status_page_id"),
+		},
+		Schema: map[string]*schema.Schema{
+			"status_page_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Set:         hashStatusPageLayoutGroup,
+				Description: "A group of components and the order they are shown in.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The heading the components are shown under. Components in a group without a name are shown on their own.",
+						},
+						"component_ids": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The components in this group, in the order they are shown.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readResourceFireHydrantStatusPageLayout(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	r, err := ac.StatusPageComponents().List(ctx, d.Get("status_page_id").(string))
+	if firehydrant.IsNotFound(err) {
+		// The layout went away along with its status page
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("group", statusPageLayoutToState(statusPageLayoutFromSet(d.Get("group").(*schema.Set)), r.Components)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func createResourceFireHydrantStatusPageLayout(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	statusPageID := d.Get("status_page_id").(string)
+	if err := applyStatusPageLayout(ctx, m.(firehydrant.Client), statusPageID, d.Get("group").(*schema.Set)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(statusPageID)
+
+	return readResourceFireHydrantStatusPageLayout(ctx, d, m)
+}
+
+func updateResourceFireHydrantStatusPageLayout(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := applyStatusPageLayout(ctx, m.(firehydrant.Client), d.Get("status_page_id").(string), d.Get("group").(*schema.Set)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return readResourceFireHydrantStatusPageLayout(ctx, d, m)
+}
+
+// deleteResourceFireHydrantStatusPageLayout leaves the components where they are, since a status page
+// always shows its components in some order
+func deleteResourceFireHydrantStatusPageLayout(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId("")
+	return diag.Diagnostics{}
+}
+
+// statusPageLayoutGroup is a group of components on a status page, in the order they are shown
+type statusPageLayoutGroup struct {
+	name         string
+	componentIDs []string
+}
+
+// applyStatusPageLayout moves each component into its group and position, only updating the components
+// that are not already where they belong
+func applyStatusPageLayout(ctx context.Context, ac firehydrant.Client, statusPageID string, groups *schema.Set) error {
+	layout := statusPageLayoutFromSet(groups)
+
+	placed := map[string]string{}
+	for _, group := range layout {
+		for _, id := range group.componentIDs {
+			if other, ok := placed[id]; ok {
+				return fmt.Errorf("component %s is in both the %q and %q groups, a component can only be in one group", id, other, group.name)
+			}
+			placed[id] = group.name
+		}
+	}
+
+	r, err := ac.StatusPageComponents().List(ctx, statusPageID)
+	if err != nil {
+		return err
+	}
+
+	current := map[string]firehydrant.StatusPageComponentResponse{}
+	for _, component := range r.Components {
+		current[component.ID] = component
+	}
+
+	for _, group := range layout {
+		// Moving a component shifts the ones after it, so once one moves the rest of the group is updated too
+		moved := false
+		for index, id := range group.componentIDs {
+			component, ok := current[id]
+			if !ok {
+				return fmt.Errorf("component %s is not on status page %s", id, statusPageID)
+			}
+
+			if !moved && component.GroupName == group.name && component.Position == index+1 {
+				continue
+			}
+			moved = true
+
+			_, err := ac.StatusPageComponents().Update(ctx, statusPageID, id, firehydrant.UpdateStatusPageComponentRequest{
+				GroupName: group.name,
+				Position:  index + 1,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func statusPageLayoutFromSet(groups *schema.Set) []statusPageLayoutGroup {
+	layout := []statusPageLayoutGroup{}
+	for _, g := range groups.List() {
+		group := g.(map[string]interface{})
+
+		componentIDs := []string{}
+		for _, id := range group["component_ids"].([]interface{}) {
+			componentIDs = append(componentIDs, id.(string))
+		}
+
+		layout = append(layout, statusPageLayoutGroup{
+			name:         group["name"].(string),
+			componentIDs: componentIDs,
+		})
+	}
+
+	return layout
+}
+
+// statusPageLayoutToState reads back the groups in the layout with only the components it manages, so
+// that components added to a group outside of Terraform never show up as drift. A managed component
+// that was moved to another group or position does
+func statusPageLayoutToState(layout []statusPageLayoutGroup, components []firehydrant.StatusPageComponentResponse) []interface{} {
+	managed := map[string]bool{}
+	for _, group := range layout {
+		for _, id := range group.componentIDs {
+			managed[id] = true
+		}
+	}
+
+	sorted := make([]firehydrant.StatusPageComponentResponse, len(components))
+	copy(sorted, components)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Position < sorted[j].Position
+	})
+
+	values := []interface{}{}
+	for _, group := range layout {
+		componentIDs := []interface{}{}
+		for _, component := range sorted {
+			if managed[component.ID] && component.GroupName == group.name {
+				componentIDs = append(componentIDs, component.ID)
+			}
+		}
+
+		values = append(values, map[string]interface{}{
+			"name":          group.name,
+			"component_ids": componentIDs,
+		})
+	}
+
+	return values
+}
+
+// hashStatusPageLayoutGroup identifies a group by its name, so that reordering its components shows up
+// as a change to the group
+func hashStatusPageLayoutGroup(v interface{}) int {
+	return schema.HashString(v.(map[string]interface{})["name"])
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestApplyStatusPageLayout(t *testing.T) {
+	var updates []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method == "PATCH" {
+			var body firehydrant.UpdateStatusPageComponentRequest
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Errorf("Received error decoding the update: %s", err.Error())
+			}
+			id := strings.TrimPrefix(req.URL.Path, "/status_pages/test-page-id/components/")
+			updates = append(updates, fmt.Sprintf("%s %s %d", id, body.GroupName, body.Position))
+			w.Write([]byte(`{}`))
+			return
+		}
+
+		w.Write([]byte(`{"data": [
+			{"id": "api", "group_name": "Core", "position": 1},
+			{"id": "dashboard", "group_name": "Core", "position": 2},
+			{"id": "checkout", "group_name": "Core", "position": 3},
+			{"id": "docs", "group_name": "", "position": 1}
+		]}`))
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceStatusPageLayout().Schema, map[string]interface{}{
+		"status_page_id": "test-page-id",
+		"group": []interface{}{
+			map[string]interface{}{"name": "Core", "component_ids": []interface{}{"api", "checkout", "dashboard"}},
+			map[string]interface{}{"name": "", "component_ids": []interface{}{"docs"}},
+		},
+	})
+
+	if err := applyStatusPageLayout(context.TODO(), ac, "test-page-id", d.Get("group").(*schema.Set)); err != nil {
+		t.Fatalf("Received error applying the layout: %s", err.Error())
+	}
+
+	// api is already first, and once checkout moves, dashboard after it is updated too
+	expected := []string{"checkout Core 2", "dashboard Core 3"}
+	if !reflect.DeepEqual(expected, updates) {
+		t.Fatalf("Expected %v, Got: %v for the component updates", expected, updates)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceStatusPageLayout().Schema, map[string]interface{}{
+		"status_page_id": "test-page-id",
+		"group": []interface{}{
+			map[string]interface{}{"name": "Core", "component_ids": []interface{}{"api"}},
+			map[string]interface{}{"name": "Other", "component_ids": []interface{}{"api"}},
+		},
+	})
+
+	err = applyStatusPageLayout(context.TODO(), ac, "test-page-id", d.Get("group").(*schema.Set))
+	if err == nil || !strings.Contains(err.Error(), "component api is in both") {
+		t.Fatalf("Expected an error for a component in two groups, Got: %v", err)
+	}
+}
+
+func TestStatusPageLayoutToState(t *testing.T) {
+	layout := []statusPageLayoutGroup{
+		{name: "Core", componentIDs: []string{"api", "dashboard"}},
+	}
+	components := []firehydrant.StatusPageComponentResponse{
+		{ID: "dashboard", GroupName: "Core", Position: 1},
+		{ID: "added-by-hand", GroupName: "Core", Position: 2},
+		{ID: "api", GroupName: "Core", Position: 3},
+	}
+
+	// The reordered components show up as drift, while the one added outside of Terraform does not
+	expected := []interface{}{
+		map[string]interface{}{"name": "Core", "component_ids": []interface{}{"dashboard", "api"}},
+	}
+	if got := statusPageLayoutToState(layout, components); !reflect.DeepEqual(expected, got) {
+		t.Fatalf("Expected %+v, Got: %+v for the layout", expected, got)
+	}
+}
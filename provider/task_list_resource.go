@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTaskList() *schema.Resource {
+	return &schema.Resource{
+		Description:   "FireHydrant task lists are checklists of tasks that can be added to an incident, such as from a runbook.",
+		CreateContext: createResourceFireHydrantTaskList,
+		UpdateContext: updateResourceFireHydrantTaskList,
+		ReadContext:   readResourceFireHydrantTaskList,
+		DeleteContext: deleteResourceFireHydrantTaskList,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tasks": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"summary": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readResourceFireHydrantTaskList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	r, err := ac.TaskLists().Get(ctx, d.Id())
+	if firehydrant.IsNotFound(err) {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := convertTaskListToState(r, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func createResourceFireHydrantTaskList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r := firehydrant.CreateTaskListRequest{
+		Name:          d.Get("name").(string),
+		Description:   d.Get("description").(string),
+		TaskListItems: taskListItemsFromState(d),
+	}
+
+	resource, err := ac.TaskLists().Create(ctx, r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.ID)
+
+	if err := convertTaskListToState(resource, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func updateResourceFireHydrantTaskList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r := firehydrant.UpdateTaskListRequest{
+		Name:          d.Get("name").(string),
+		Description:   d.Get("description").(string),
+		TaskListItems: taskListItemsFromState(d),
+	}
+
+	_, err := ac.TaskLists().Update(ctx, d.Id(), r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func deleteResourceFireHydrantTaskList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	err := ac.TaskLists().Delete(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return diag.Diagnostics{}
+}
+
+func taskListItemsFromState(d *schema.ResourceData) []firehydrant.TaskListItem {
+	items := []firehydrant.TaskListItem{}
+
+	for _, task := range d.Get("tasks").([]interface{}) {
+		t := task.(map[string]interface{})
+		items = append(items, firehydrant.TaskListItem{
+			Summary:     t["summary"].(string),
+			Description: t["description"].(string),
+		})
+	}
+
+	return items
+}
+
+func convertTaskListToState(taskList *firehydrant.TaskListResponse, d *schema.ResourceData) error {
+	attributes := map[string]interface{}{
+		"name":        taskList.Name,
+		"description": taskList.Description,
+	}
+
+	if err := setAttributesFromMap(d, attributes); err != nil {
+		return err
+	}
+
+	// Tasks are kept in the order FireHydrant returns them, so reordering them is a change
+	tasks := make([]interface{}, len(taskList.TaskListItems))
+	for index, item := range taskList.TaskListItems {
+		tasks[index] = map[string]interface{}{
+			"summary":     item.Summary,
+			"description": item.Description,
+		}
+	}
+
+	return d.Set("tasks", tasks)
+}
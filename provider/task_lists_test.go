@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTaskLists(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testFireHydrantIsSetup(t) },
+		ProviderFactories: defaultProviderFactories(),
+		CheckDestroy:      testTaskListDoesNotExist("firehydrant_task_list.terraform-acceptance-test-task-list"),
+		Steps: []resource.TestStep{
+			{
+				Config: testTaskListConfig(rName, "Page the database team", "Open a status page"),
+				Check: resource.ComposeTestCheckFunc(
+					testTaskListExists("firehydrant_task_list.terraform-acceptance-test-task-list"),
+					resource.TestCheckResourceAttr("firehydrant_task_list.terraform-acceptance-test-task-list", "name", rName),
+					resource.TestCheckResourceAttr("firehydrant_task_list.terraform-acceptance-test-task-list", "tasks.#", "2"),
+					resource.TestCheckResourceAttr("firehydrant_task_list.terraform-acceptance-test-task-list", "tasks.0.summary", "Page the database team"),
+					resource.TestCheckResourceAttr("firehydrant_task_list.terraform-acceptance-test-task-list", "tasks.1.summary", "Open a status page"),
+				),
+			},
+			{
+				Config: testTaskListConfig(rName, "Open a status page", "Page the database team"),
+				Check: resource.ComposeTestCheckFunc(
+					testTaskListExists("firehydrant_task_list.terraform-acceptance-test-task-list"),
+					resource.TestCheckResourceAttr("firehydrant_task_list.terraform-acceptance-test-task-list", "tasks.0.summary", "Open a status page"),
+					resource.TestCheckResourceAttr("firehydrant_task_list.terraform-acceptance-test-task-list", "tasks.1.summary", "Page the database team"),
+				),
+			},
+		},
+	})
+}
+
+const testTaskListConfigTemplate = `
+resource "firehydrant_task_list" "terraform-acceptance-test-task-list" {
+	name        = "%s"
+	description = "A task list created by the acceptance tests"
+
+	tasks {
+		summary = "%s"
+	}
+
+	tasks {
+		summary     = "%s"
+		description = "Let customers know we are on it"
+	}
+}
+`
+
+func testTaskListConfig(rName, firstTask, secondTask string) string {
+	return fmt.Sprintf(testTaskListConfigTemplate, rName, firstTask, secondTask)
+}
+
+func testTaskListExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		taskList, err := c.TaskLists().Get(context.TODO(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if expected, got := rs.Primary.Attributes["tasks.#"], fmt.Sprint(len(taskList.TaskListItems)); expected != got {
+			return fmt.Errorf("Expected %s tasks, got %s", expected, got)
+		}
+
+		for index, item := range taskList.TaskListItems {
+			if expected, got := rs.Primary.Attributes[fmt.Sprintf("tasks.%d.summary", index)], item.Summary; expected != got {
+				return fmt.Errorf("Expected task %d to be %s, got %s", index, expected, got)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testTaskListDoesNotExist(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return nil
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		taskList, err := c.TaskLists().Get(context.TODO(), rs.Primary.ID)
+		if taskList != nil {
+			return fmt.Errorf("The task list existed, when it should not")
+		}
+
+		if !firehydrant.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTeam() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataFireHydrantTeam,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"slug": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataFireHydrantTeam(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	var r *firehydrant.TeamResponse
+	if id := d.Get("id").(string); id != "" {
+		team, err := ac.GetTeam(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		r = team
+	} else {
+		team, err := findTeamByName(ctx, ac, d.Get("name").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		r = team
+	}
+
+	serviceIDs := make([]string, len(r.Services))
+	for index, s := range r.Services {
+		serviceIDs[index] = s.ID
+	}
+
+	attributes := map[string]interface{}{
+		"name":        r.Name,
+		"description": r.Description,
+		"slug":        r.Slug,
+		"service_ids": serviceIDs,
+	}
+	if err := setAttributesFromMap(d, attributes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(r.ID)
+
+	return diag.Diagnostics{}
+}
+
+// findTeamByName resolves a team name using the team search, which matches more than the exact name
+func findTeamByName(ctx context.Context, ac firehydrant.Client, name string) (*firehydrant.TeamResponse, error) {
+	teams, err := ac.ListTeams(ctx, &firehydrant.TeamQuery{Query: name})
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []firehydrant.TeamResponse
+	for _, team := range teams.Teams {
+		if team.Name == name {
+			matches = append(matches, team)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("could not find a team named %q", name)
+	case 1:
+		// The search results may leave out some of a team's details, such as its services
+		return ac.GetTeam(ctx, matches[0].ID)
+	}
+
+	slugs := make([]string, len(matches))
+	for index, team := range matches {
+		slugs[index] = team.Slug
+	}
+
+	return nil, fmt.Errorf("found %d teams named %q (slugs: %s), set id instead of name to pick one", len(matches), name, strings.Join(slugs, ", "))
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -26,6 +27,19 @@ func resourceTeam() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSlug,
+				Description:  "The slug used to refer to the team, which may only contain lowercase letters, numbers, hyphens, and underscores. FireHydrant generates one from the name when this is not set. Changing it replaces the team.",
+			},
+			"default_escalation_policy_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The escalation policy pages for this team go to. It has to be one of this team's own escalation policies.",
+			},
 			"services": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -42,6 +56,41 @@ func resourceTeam() *schema.Resource {
 					},
 				},
 			},
+			"memberships": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Users on this team. Only the users listed here are managed; anyone added to the team outside of Terraform is left alone.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"default_roles": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Incident roles that are filled in when this team declares an incident.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"incident_role_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"user_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The user the role is assigned to. The role is left for someone to pick up when not set.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -49,18 +98,20 @@ func resourceTeam() *schema.Resource {
 func readResourceFireHydrantTeam(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ac := m.(firehydrant.Client)
 	r, err := ac.GetTeam(ctx, d.Id())
-	if err != nil {
-		return diag.FromErr(err)
+	if firehydrant.IsNotFound(err) {
+		d.SetId("")
+		return diag.Diagnostics{}
 	}
-
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	var ds diag.Diagnostics
 	svc := map[string]string{
-		"name":        r.Name,
-		"description": r.Description,
+		"name":                         r.Name,
+		"description":                  r.Description,
+		"slug":                         r.Slug,
+		"default_escalation_policy_id": r.DefaultEscalationPolicyID,
 	}
 
 	for key, val := range svc {
@@ -81,6 +132,15 @@ func readResourceFireHydrantTeam(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
+	if err := d.Set("memberships", managedTeamMemberships(d, r.Memberships)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Incident roles that were deleted are no longer default roles, so they show up as a change
+	if err := d.Set("default_roles", teamDefaultRolesToState(r.DefaultIncidentRoles)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return ds
 }
 
@@ -91,6 +151,7 @@ func createResourceFireHydrantTeam(ctx context.Context, d *schema.ResourceData,
 	r := firehydrant.CreateTeamRequest{
 		Name:        name,
 		Description: description,
+		Slug:        d.Get("slug").(string),
 		ServiceIDs:  []string{},
 	}
 
@@ -102,7 +163,11 @@ func createResourceFireHydrantTeam(ctx context.Context, d *schema.ResourceData,
 
 	resource, err := ac.CreateTeam(ctx, r)
 	if err != nil {
-		return diag.FromErr(err)
+		// A taken slug is explained better than FireHydrant's field error for it
+		if slugErr := slugTakenError(err, "team", r.Slug); slugErr != err {
+			return diag.FromErr(slugErr)
+		}
+		return fieldErrorDiagnostics(err, map[string][]string{"service_ids": r.ServiceIDs})
 	}
 
 	d.SetId(resource.ID)
@@ -110,6 +175,7 @@ func createResourceFireHydrantTeam(ctx context.Context, d *schema.ResourceData,
 	attributes := map[string]interface{}{
 		"name":        resource.Name,
 		"description": resource.Description,
+		"slug":        resource.Slug,
 	}
 
 	if err := setAttributesFromMap(d, attributes); err != nil {
@@ -128,6 +194,27 @@ func createResourceFireHydrantTeam(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	if ds := createTeamMemberships(ctx, ac, resource.ID, teamMembershipsFromSet(d.Get("memberships").(*schema.Set))); ds.HasError() {
+		return ds
+	}
+
+	for _, role := range teamDefaultRolesFromSet(d.Get("default_roles").(*schema.Set)) {
+		if err := createTeamDefaultRole(ctx, ac, resource.ID, role); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// The team has to exist before its escalation policy can be set
+	if policyID := d.Get("default_escalation_policy_id").(string); policyID != "" {
+		if err := checkTeamEscalationPolicy(ctx, ac, resource.ID, policyID); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if _, err := ac.UpdateTeam(ctx, resource.ID, firehydrant.UpdateTeamRequest{DefaultEscalationPolicyID: &policyID}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	var ds diag.Diagnostics
 	return ds
 }
@@ -149,9 +236,20 @@ func updateResourceFireHydrantTeam(ctx context.Context, d *schema.ResourceData,
 		r.ServiceIDs = append(r.ServiceIDs, data["id"].(string))
 	}
 
+	if d.HasChange("default_escalation_policy_id") {
+		policyID := d.Get("default_escalation_policy_id").(string)
+		if policyID != "" {
+			if err := checkTeamEscalationPolicy(ctx, ac, id, policyID); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		r.DefaultEscalationPolicyID = &policyID
+	}
+
 	functionality, err := ac.UpdateTeam(ctx, id, r)
 	if err != nil {
-		return diag.FromErr(err)
+		return fieldErrorDiagnostics(err, map[string][]string{"service_ids": r.ServiceIDs})
 	}
 
 	svcs := make([]interface{}, len(functionality.Services))
@@ -166,6 +264,42 @@ func updateResourceFireHydrantTeam(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	if d.HasChange("memberships") {
+		o, n := d.GetChange("memberships")
+		oldMemberships, newMemberships := o.(*schema.Set), n.(*schema.Set)
+
+		// Memberships are removed first so that changing a user's role removes and then re-adds them
+		for _, membership := range teamMembershipsFromSet(oldMemberships.Difference(newMemberships)) {
+			// Users who have left FireHydrant are already off the team
+			if err := ac.DeleteTeamMembership(ctx, id, membership.UserID); err != nil && !firehydrant.IsNotFound(err) {
+				return diag.FromErr(err)
+			}
+		}
+
+		if ds := createTeamMemberships(ctx, ac, id, teamMembershipsFromSet(newMemberships.Difference(oldMemberships))); ds.HasError() {
+			return ds
+		}
+	}
+
+	if d.HasChange("default_roles") {
+		o, n := d.GetChange("default_roles")
+		oldRoles, newRoles := o.(*schema.Set), n.(*schema.Set)
+
+		// Roles are removed first so that changing who a role is assigned to removes and then re-adds it
+		for _, role := range teamDefaultRolesFromSet(oldRoles.Difference(newRoles)) {
+			// Incident roles that were deleted are already gone from the team
+			if err := ac.DeleteTeamDefaultIncidentRole(ctx, id, role.IncidentRoleID); err != nil && !firehydrant.IsNotFound(err) {
+				return diag.FromErr(err)
+			}
+		}
+
+		for _, role := range teamDefaultRolesFromSet(newRoles.Difference(oldRoles)) {
+			if err := createTeamDefaultRole(ctx, ac, id, role); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	return diag.Diagnostics{}
 }
 
@@ -181,3 +315,101 @@ func deleteResourceFireHydrantTeam(ctx context.Context, d *schema.ResourceData,
 	d.SetId("")
 	return diag.Diagnostics{}
 }
+
+func teamMembershipsFromSet(set *schema.Set) []firehydrant.TeamMembership {
+	memberships := []firehydrant.TeamMembership{}
+	for _, membership := range set.List() {
+		m := membership.(map[string]interface{})
+		memberships = append(memberships, firehydrant.TeamMembership{
+			UserID: m["user_id"].(string),
+			Role:   m["role"].(string),
+		})
+	}
+
+	return memberships
+}
+
+// managedTeamMemberships keeps only the memberships of users already in the configuration or state,
+// so that people added to the team by hand never show up as drift
+func managedTeamMemberships(d *schema.ResourceData, memberships []firehydrant.TeamMembership) []interface{} {
+	managed := map[string]bool{}
+	for _, membership := range teamMembershipsFromSet(d.Get("memberships").(*schema.Set)) {
+		managed[membership.UserID] = true
+	}
+
+	values := []interface{}{}
+	for _, membership := range memberships {
+		if managed[membership.UserID] {
+			values = append(values, map[string]interface{}{
+				"user_id": membership.UserID,
+				"role":    membership.Role,
+			})
+		}
+	}
+
+	return values
+}
+
+// createTeamDefaultRole adds a default role to a team, pointing out when the incident role has been deleted
+// since that can only be fixed by changing the configuration
+func createTeamDefaultRole(ctx context.Context, ac firehydrant.Client, teamID string, role firehydrant.TeamDefaultIncidentRole) error {
+	err := ac.CreateTeamDefaultIncidentRole(ctx, teamID, role)
+	if firehydrant.IsNotFound(err) {
+		return fmt.Errorf("incident role %s no longer exists, remove it from default_roles: %w", role.IncidentRoleID, err)
+	}
+
+	return err
+}
+
+// checkTeamEscalationPolicy makes sure a team's default escalation policy is one of the team's own, since
+// FireHydrant only looks up escalation policies under the team they belong to
+func checkTeamEscalationPolicy(ctx context.Context, ac firehydrant.Client, teamID, policyID string) error {
+	_, err := ac.EscalationPolicies().Get(ctx, teamID, policyID)
+	if firehydrant.IsNotFound(err) {
+		return fmt.Errorf("escalation policy %s does not belong to team %s, default_escalation_policy_id has to be one of the team's own escalation policies: %w", policyID, teamID, err)
+	}
+
+	return err
+}
+
+func teamDefaultRolesFromSet(set *schema.Set) []firehydrant.TeamDefaultIncidentRole {
+	roles := []firehydrant.TeamDefaultIncidentRole{}
+	for _, role := range set.List() {
+		r := role.(map[string]interface{})
+		roles = append(roles, firehydrant.TeamDefaultIncidentRole{
+			IncidentRoleID: r["incident_role_id"].(string),
+			UserID:         r["user_id"].(string),
+		})
+	}
+
+	return roles
+}
+
+func teamDefaultRolesToState(roles []firehydrant.TeamDefaultIncidentRole) []interface{} {
+	values := make([]interface{}, len(roles))
+	for index, role := range roles {
+		values[index] = map[string]interface{}{
+			"incident_role_id": role.IncidentRoleID,
+			"user_id":          role.UserID,
+		}
+	}
+
+	return values
+}
+
+// createTeamMemberships adds every membership it can, reporting each one FireHydrant rejects with the
+// user it was for rather than stopping at the first
+func createTeamMemberships(ctx context.Context, ac firehydrant.Client, teamID string, memberships []firehydrant.TeamMembership) diag.Diagnostics {
+	ds := diag.Diagnostics{}
+	for _, membership := range memberships {
+		if err := ac.CreateTeamMembership(ctx, teamID, membership); err != nil {
+			ds = append(ds, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("could not add user %q to the team", membership.UserID),
+				Detail:   err.Error(),
+			})
+		}
+	}
+
+	return ds
+}
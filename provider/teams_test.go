@@ -2,14 +2,21 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -51,6 +58,156 @@ func TestAccTeams(t *testing.T) {
 	})
 }
 
+func TestAccTeamDataSource(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testFireHydrantIsSetup(t) },
+		ProviderFactories: defaultProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testTeamDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.firehydrant_team.by_name", "id", "firehydrant_team.terraform-acceptance-test-team", "id"),
+					resource.TestCheckResourceAttrPair("data.firehydrant_team.by_id", "name", "firehydrant_team.terraform-acceptance-test-team", "name"),
+					resource.TestCheckResourceAttr("data.firehydrant_team.by_name", "service_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.firehydrant_team.by_name", "service_ids.0", "firehydrant_service.service", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestManagedTeamMemberships(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceTeam().Schema, map[string]interface{}{
+		"name": "team",
+		"memberships": []interface{}{
+			map[string]interface{}{"user_id": "managed-user", "role": "responder"},
+		},
+	})
+
+	memberships := []firehydrant.TeamMembership{
+		{UserID: "managed-user", Role: "owner"},
+		{UserID: "added-by-hand", Role: "responder"},
+	}
+
+	// The managed user's role changing outside of Terraform is still reported
+	expected := []interface{}{
+		map[string]interface{}{"user_id": "managed-user", "role": "owner"},
+	}
+
+	if got := managedTeamMemberships(d, memberships); !reflect.DeepEqual(expected, got) {
+		t.Fatalf("Expected %+v, Got: %+v for managed team memberships", expected, got)
+	}
+}
+
+func TestCreateTeamDefaultRoleDeletedIncidentRole(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"detail": "Record not found"}`))
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	err = createTeamDefaultRole(context.TODO(), ac, "test-team-id", firehydrant.TeamDefaultIncidentRole{IncidentRoleID: "deleted-role"})
+	if err == nil || !strings.Contains(err.Error(), "incident role deleted-role no longer exists, remove it from default_roles") {
+		t.Fatalf("Expected an error pointing out the deleted incident role, Got: %v", err)
+	}
+}
+
+func TestCreateTeamSlugTaken(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"error": "Invalid request", "errors": [{"field": "slug", "message": "has already been taken"}]}`))
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceTeam().Schema, map[string]interface{}{
+		"name": "team",
+		"slug": "platform",
+	})
+
+	diags := createResourceFireHydrantTeam(context.TODO(), d, ac)
+	if !diags.HasError() {
+		t.Fatalf("Expected an error creating a team with a taken slug")
+	}
+
+	expected := `slug "platform" could not be used, check that no other team already has it`
+	if !strings.Contains(diags[0].Summary, expected) {
+		t.Errorf("Expected the error to contain %q, Got: %s", expected, diags[0].Summary)
+	}
+}
+
+func TestUpdateTeamDefaultEscalationPolicy(t *testing.T) {
+	var sent map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.URL.Path == "/teams/test-team-id/escalation_policies/other-teams-policy":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail": "Record not found"}`))
+		case req.URL.Path == "/teams/test-team-id/escalation_policies/primary-policy":
+			w.Write([]byte(`{"id": "primary-policy", "name": "Primary"}`))
+		case req.Method == "PATCH":
+			if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+				t.Errorf("Received error decoding the update: %s", err.Error())
+			}
+			w.Write([]byte(`{"id": "test-team-id", "name": "team"}`))
+		default:
+			t.Errorf("Unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	update := func(policyID string) diag.Diagnostics {
+		state := &terraform.InstanceState{
+			ID:         "test-team-id",
+			Attributes: map[string]string{"id": "test-team-id", "name": "team"},
+		}
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":                         "team",
+			"default_escalation_policy_id": policyID,
+		})
+
+		r := resourceTeam()
+		diff, err := r.Diff(context.TODO(), state, config, ac)
+		if err != nil {
+			t.Fatalf("Received error planning the update: %s", err.Error())
+		}
+
+		_, diags := r.Apply(context.TODO(), state, diff, ac)
+		return diags
+	}
+
+	diags := update("other-teams-policy")
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "escalation policy other-teams-policy does not belong to team test-team-id") {
+		t.Fatalf("Expected an error pointing out the other team's escalation policy, Got: %+v", diags)
+	}
+	if sent != nil {
+		t.Fatalf("Expected the team not to be updated with another team's escalation policy")
+	}
+
+	if diags := update("primary-policy"); diags.HasError() {
+		t.Fatalf("Received error updating the team's escalation policy: %+v", diags)
+	}
+	if sent["default_escalation_policy_id"] != "primary-policy" {
+		t.Fatalf("Expected the update to set the escalation policy, Got: %+v", sent)
+	}
+}
+
 const testTeamConfigTemplate = `
 resource "firehydrant_team" "terraform-acceptance-test-team" {
 	name = "%s"
@@ -79,6 +236,20 @@ func testTeamConfigWithService(rName string) string {
 	return fmt.Sprintf(testTeamWithService, rName)
 }
 
+const testTeamDataSource = `
+data "firehydrant_team" "by_name" {
+	name = firehydrant_team.terraform-acceptance-test-team.name
+}
+
+data "firehydrant_team" "by_id" {
+	id = firehydrant_team.terraform-acceptance-test-team.id
+}
+`
+
+func testTeamDataSourceConfig(rName string) string {
+	return testTeamConfigWithService(rName) + testTeamDataSource
+}
+
 func testTeamExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -131,7 +302,7 @@ func testTeamDoesNotExist(resourceName string) resource.TestCheckFunc {
 		// 	return fmt.Errorf("The team existed, when it should not")
 		// }
 
-		// if _, isNotFound := err.(firehydrant.NotFound); !isNotFound {
+		// if !firehydrant.IsNotFound(err) {
 		// 	return err
 		// }
 
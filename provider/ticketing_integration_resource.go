@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTicketingIntegration() *schema.Resource {
+	return &schema.Resource{
+		Description:   "FireHydrant ticketing integrations push incidents to a ticketing tool, such as Jira, through one of your integration connections.",
+		CreateContext: createResourceFireHydrantTicketingIntegration,
+		UpdateContext: updateResourceFireHydrantTicketingIntegration,
+		ReadContext:   readResourceFireHydrantTicketingIntegration,
+		DeleteContext: deleteResourceFireHydrantTicketingIntegration,
+		Importer: &schema.ResourceImporter{
+			StateContext: importParentID("connection_id"),
+		},
+		Schema: map[string]*schema.Schema{
+			"connection_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the connection incidents are pushed through, such as one from the `firehydrant_integration_connections` data source.",
+			},
+			"project_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The key of the project tickets are created in, such as INC.",
+			},
+			"issue_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The type of issue tickets are created as, such as Incident.",
+			},
+			"field_mappings": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Which ticket field each FireHydrant field is written to, such as `{ severity = \"priority\" }`.",
+			},
+			// Like webhook secrets, FireHydrant never returns the token, so it is only ever sent
+			"api_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The API token tickets are created with, when the connection does not already have one.",
+			},
+		},
+	}
+}
+
+// A connection has at most one ticketing config, so these use the connection's ID as their own
+
+func readResourceFireHydrantTicketingIntegration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	r, err := ac.Integrations().GetTicketingConfig(ctx, d.Id())
+	if firehydrant.IsNotFound(err) {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := convertTicketingConfigToState(r, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func createResourceFireHydrantTicketingIntegration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	connectionID := d.Get("connection_id").(string)
+
+	r := ticketingConfigFromState(d)
+	r.APIToken = d.Get("api_token").(string)
+
+	resource, err := ac.Integrations().UpdateTicketingConfig(ctx, connectionID, r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(connectionID)
+
+	if err := convertTicketingConfigToState(resource, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func updateResourceFireHydrantTicketingIntegration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r := ticketingConfigFromState(d)
+
+	// Only send the token when it changes so that one set outside of Terraform is not put back
+	if d.HasChange("api_token") {
+		r.APIToken = d.Get("api_token").(string)
+	}
+
+	_, err := ac.Integrations().UpdateTicketingConfig(ctx, d.Id(), r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func deleteResourceFireHydrantTicketingIntegration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	err := ac.Integrations().DeleteTicketingConfig(ctx, d.Id())
+	if err != nil && !firehydrant.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return diag.Diagnostics{}
+}
+
+func ticketingConfigFromState(d *schema.ResourceData) firehydrant.UpdateTicketingConfigRequest {
+	return firehydrant.UpdateTicketingConfigRequest{
+		ProjectKey:    d.Get("project_key").(string),
+		IssueType:     d.Get("issue_type").(string),
+		FieldMappings: convertStringMap(d.Get("field_mappings").(map[string]interface{})),
+	}
+}
+
+func convertTicketingConfigToState(config *firehydrant.TicketingConfigResponse, d *schema.ResourceData) error {
+	attributes := map[string]interface{}{
+		"project_key":    config.ProjectKey,
+		"issue_type":     config.IssueType,
+		"field_mappings": config.FieldMappings,
+	}
+
+	return setAttributesFromMap(d, attributes)
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestTicketingIntegration(t *testing.T) {
+	var updates []firehydrant.UpdateTicketingConfigRequest
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/integrations/connections/test-jira-connection/ticketing_config" || req.Method != http.MethodPut {
+			t.Errorf("Unexpected request %s %s", req.Method, req.URL.Path)
+		}
+
+		var updated firehydrant.UpdateTicketingConfigRequest
+		if err := json.NewDecoder(req.Body).Decode(&updated); err != nil {
+			t.Errorf("Could not decode request: %s", err)
+		}
+		updates = append(updates, updated)
+
+		json.NewEncoder(w).Encode(firehydrant.TicketingConfigResponse{
+			ProjectKey:    updated.ProjectKey,
+			IssueType:     updated.IssueType,
+			FieldMappings: updated.FieldMappings,
+		})
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceTicketingIntegration().Schema, map[string]interface{}{
+		"connection_id":  "test-jira-connection",
+		"project_key":    "INC",
+		"issue_type":     "Incident",
+		"field_mappings": map[string]interface{}{"severity": "priority"},
+		"api_token":      "test-token",
+	})
+
+	if diags := createResourceFireHydrantTicketingIntegration(context.TODO(), d, ac); diags.HasError() {
+		t.Fatalf("Received error creating ticketing integration: %+v", diags)
+	}
+
+	if d.Id() != "test-jira-connection" {
+		t.Fatalf("Expected the connection's ID to be used, Got: %s", d.Id())
+	}
+
+	expected := firehydrant.UpdateTicketingConfigRequest{
+		ProjectKey:    "INC",
+		IssueType:     "Incident",
+		FieldMappings: map[string]string{"severity": "priority"},
+		APIToken:      "test-token",
+	}
+	if len(updates) != 1 || !reflect.DeepEqual(expected, updates[0]) {
+		t.Fatalf("Expected %+v, Got: %+v for the config sent", expected, updates)
+	}
+
+	// The token has not changed, so updating the project does not send it again
+	d = schema.TestResourceDataRaw(t, resourceTicketingIntegration().Schema, map[string]interface{}{
+		"connection_id": "test-jira-connection",
+		"project_key":   "OPS",
+		"issue_type":    "Incident",
+	})
+	d.SetId("test-jira-connection")
+
+	if diags := updateResourceFireHydrantTicketingIntegration(context.TODO(), d, ac); diags.HasError() {
+		t.Fatalf("Received error updating ticketing integration: %+v", diags)
+	}
+
+	if len(updates) != 2 || updates[1].APIToken != "" || updates[1].ProjectKey != "OPS" {
+		t.Fatalf("Expected the project to change without sending the token, Got: %+v", updates[1])
+	}
+}
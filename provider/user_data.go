@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceUser() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataFireHydrantUser,
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataFireHydrantUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r, err := findUserByEmail(ctx, ac, d.Get("email").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// email is left as configured, since FireHydrant matches it regardless of case
+	if err := d.Set("name", r.Name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(r.ID)
+
+	return diag.Diagnostics{}
+}
+
+// findUserByEmail resolves an email using the user search, which also matches names and partial emails
+func findUserByEmail(ctx context.Context, ac firehydrant.Client, email string) (*firehydrant.UserResponse, error) {
+	users, err := ac.Users().List(ctx, &firehydrant.UserQuery{Query: email})
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []firehydrant.UserResponse
+	for _, user := range users.Users {
+		if strings.EqualFold(user.Email, email) {
+			matches = append(matches, user)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("could not find a user with the email %q", email)
+	case 1:
+		return &matches[0], nil
+	}
+
+	found := make([]string, len(matches))
+	for index, user := range matches {
+		found[index] = fmt.Sprintf("%s (%s)", user.Name, user.ID)
+	}
+
+	return nil, fmt.Errorf("found %d users with the email %q: %s", len(matches), email, strings.Join(found, ", "))
+}
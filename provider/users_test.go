@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccUserDataSourceNotFound(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testFireHydrantIsSetup(t) },
+		ProviderFactories: defaultProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testUserDataSourceConfig(rName),
+				ExpectError: regexp.MustCompile("could not find a user with the email"),
+			},
+		},
+	})
+}
+
+const testUserDataSourceTemplate = `
+data "firehydrant_user" "user" {
+	email = "%s@example.com"
+}
+`
+
+func testUserDataSourceConfig(rName string) string {
+	return fmt.Sprintf(testUserDataSourceTemplate, rName)
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceWebhook() *schema.Resource {
+	return &schema.Resource{
+		Description:   "FireHydrant webhooks send incident lifecycle events to a URL of your choosing.",
+		CreateContext: createResourceFireHydrantWebhook,
+		UpdateContext: updateResourceFireHydrantWebhook,
+		ReadContext:   readResourceFireHydrantWebhook,
+		DeleteContext: deleteResourceFireHydrantWebhook,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "active",
+				ValidateFunc: validation.StringInSlice([]string{"active", "inactive"}, false),
+			},
+			"subscriptions": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The event types sent to this webhook, such as incidents.",
+			},
+			// FireHydrant never returns the secret, so it is only ever sent and never read back. That
+			// also means a secret FireHydrant rotates on its own does not show up as a diff
+			"secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The secret FireHydrant signs webhook payloads with.",
+			},
+		},
+	}
+}
+
+func readResourceFireHydrantWebhook(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	r, err := ac.Webhooks().Get(ctx, d.Id())
+	if firehydrant.IsNotFound(err) {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := convertWebhookToState(r, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func createResourceFireHydrantWebhook(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r := firehydrant.CreateWebhookRequest{
+		URL:           d.Get("url").(string),
+		Secret:        d.Get("secret").(string),
+		State:         d.Get("state").(string),
+		Subscriptions: webhookSubscriptionsFromState(d),
+	}
+
+	resource, err := ac.Webhooks().Create(ctx, r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.ID)
+
+	if err := convertWebhookToState(resource, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func updateResourceFireHydrantWebhook(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	r := firehydrant.UpdateWebhookRequest{
+		URL:           d.Get("url").(string),
+		State:         d.Get("state").(string),
+		Subscriptions: webhookSubscriptionsFromState(d),
+	}
+
+	// Only send the secret when it changes so that one FireHydrant rotated is not put back
+	if d.HasChange("secret") {
+		r.Secret = d.Get("secret").(string)
+	}
+
+	_, err := ac.Webhooks().Update(ctx, d.Id(), r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func deleteResourceFireHydrantWebhook(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+
+	err := ac.Webhooks().Delete(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return diag.Diagnostics{}
+}
+
+func webhookSubscriptionsFromState(d *schema.ResourceData) []string {
+	subscriptions := []string{}
+	for _, subscription := range d.Get("subscriptions").(*schema.Set).List() {
+		subscriptions = append(subscriptions, subscription.(string))
+	}
+
+	return subscriptions
+}
+
+func convertWebhookToState(webhook *firehydrant.WebhookResponse, d *schema.ResourceData) error {
+	attributes := map[string]interface{}{
+		"url":           webhook.URL,
+		"state":         webhook.State,
+		"subscriptions": webhook.Subscriptions,
+	}
+
+	return setAttributesFromMap(d, attributes)
+}
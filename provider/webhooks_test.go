@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccWebhooks(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testFireHydrantIsSetup(t) },
+		ProviderFactories: defaultProviderFactories(),
+		CheckDestroy:      testWebhookDoesNotExist("firehydrant_webhook.terraform-acceptance-test-webhook"),
+		Steps: []resource.TestStep{
+			{
+				Config: testWebhookConfig("active", "first-secret"),
+				Check: resource.ComposeTestCheckFunc(
+					testWebhookExists("firehydrant_webhook.terraform-acceptance-test-webhook"),
+					resource.TestCheckResourceAttr("firehydrant_webhook.terraform-acceptance-test-webhook", "state", "active"),
+					resource.TestCheckResourceAttr("firehydrant_webhook.terraform-acceptance-test-webhook", "subscriptions.#", "1"),
+					resource.TestCheckResourceAttr("firehydrant_webhook.terraform-acceptance-test-webhook", "secret", "first-secret"),
+				),
+			},
+			{
+				Config: testWebhookConfig("inactive", "second-secret"),
+				Check: resource.ComposeTestCheckFunc(
+					testWebhookExists("firehydrant_webhook.terraform-acceptance-test-webhook"),
+					resource.TestCheckResourceAttr("firehydrant_webhook.terraform-acceptance-test-webhook", "state", "inactive"),
+					resource.TestCheckResourceAttr("firehydrant_webhook.terraform-acceptance-test-webhook", "secret", "second-secret"),
+				),
+			},
+		},
+	})
+}
+
+const testWebhookConfigTemplate = `
+resource "firehydrant_webhook" "terraform-acceptance-test-webhook" {
+	url           = "https://example.com/firehydrant"
+	state         = "%s"
+	secret        = "%s"
+	subscriptions = ["incidents"]
+}
+`
+
+func testWebhookConfig(state, secret string) string {
+	return fmt.Sprintf(testWebhookConfigTemplate, state, secret)
+}
+
+func testWebhookExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		webhook, err := c.Webhooks().Get(context.TODO(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if expected, got := rs.Primary.Attributes["state"], webhook.State; expected != got {
+			return fmt.Errorf("Expected state %s, got %s", expected, got)
+		}
+
+		return nil
+	}
+}
+
+func testWebhookDoesNotExist(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return nil
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		webhook, err := c.Webhooks().Get(context.TODO(), rs.Primary.ID)
+		if webhook != nil {
+			return fmt.Errorf("The webhook existed, when it should not")
+		}
+
+		if !firehydrant.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}
+}
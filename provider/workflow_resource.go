@@ -0,0 +1,333 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceWorkflow() *schema.Resource {
+	return &schema.Resource{
+		Description:   "FireHydrant workflows run a list of runbook actions, in order, when an event matching their trigger happens.",
+		CreateContext: createResourceFireHydrantWorkflow,
+		UpdateContext: updateResourceFireHydrantWorkflow,
+		ReadContext:   readResourceFireHydrantWorkflow,
+		DeleteContext: deleteResourceFireHydrantWorkflow,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(slowResourceTimeout),
+			Update: schema.DefaultTimeout(slowResourceTimeout),
+			Delete: schema.DefaultTimeout(slowResourceTimeout),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"role_assignment": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Incident roles assigned on the incidents the workflow runs for, so that they start out staffed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"incident_role_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"user_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The user assigned the role. Exactly one of user_id or schedule_id has to be set.",
+						},
+						"schedule_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The on-call schedule whose current responder is assigned the role. Exactly one of user_id or schedule_id has to be set.",
+						},
+					},
+				},
+			},
+			// Only the name, description, and role assignments can be updated, so the trigger and steps
+			// replace the workflow
+			"trigger": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The event that starts the workflow, such as incident.opened.",
+						},
+						"condition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"field": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"operator": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"steps": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"step_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"config": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readResourceFireHydrantWorkflow(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ac := m.(firehydrant.Client)
+	r, err := ac.Workflows().Get(ctx, d.Id())
+	if firehydrant.IsNotFound(err) {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := convertWorkflowToState(r, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func createResourceFireHydrantWorkflow(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ctx = withResourceTimeout(ctx, d, schema.TimeoutCreate)
+	ac := m.(firehydrant.Client)
+
+	roleAssignments, err := workflowRoleAssignmentsFromState(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	r := firehydrant.CreateWorkflowRequest{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Trigger:     workflowTriggerFromState(d),
+		Steps:       workflowStepsFromState(d),
+
+		RoleAssignments: roleAssignments,
+	}
+
+	resource, err := ac.Workflows().Create(ctx, r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.ID)
+
+	if err := convertWorkflowToState(resource, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func updateResourceFireHydrantWorkflow(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ctx = withResourceTimeout(ctx, d, schema.TimeoutUpdate)
+	ac := m.(firehydrant.Client)
+
+	r := firehydrant.UpdateWorkflowRequest{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	// Role assignments removed from the configuration are removed from the workflow, since the list sent
+	// replaces the workflow's assignments
+	if d.HasChange("role_assignment") {
+		roleAssignments, err := workflowRoleAssignmentsFromState(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		r.RoleAssignments = &roleAssignments
+	}
+
+	_, err := ac.Workflows().Update(ctx, d.Id(), r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func deleteResourceFireHydrantWorkflow(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ctx = withResourceTimeout(ctx, d, schema.TimeoutDelete)
+	ac := m.(firehydrant.Client)
+
+	err := ac.Workflows().Delete(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return diag.Diagnostics{}
+}
+
+func workflowTriggerFromState(d *schema.ResourceData) firehydrant.WorkflowTrigger {
+	t := d.Get("trigger").([]interface{})[0].(map[string]interface{})
+
+	trigger := firehydrant.WorkflowTrigger{
+		Event:      t["event"].(string),
+		Conditions: []firehydrant.WorkflowCondition{},
+	}
+
+	for _, condition := range t["condition"].([]interface{}) {
+		c := condition.(map[string]interface{})
+		trigger.Conditions = append(trigger.Conditions, firehydrant.WorkflowCondition{
+			Field:    c["field"].(string),
+			Operator: c["operator"].(string),
+			Value:    c["value"].(string),
+		})
+	}
+
+	return trigger
+}
+
+// workflowStepsFromState builds the ordered list of workflow steps from the resource configuration
+func workflowStepsFromState(d *schema.ResourceData) []firehydrant.WorkflowStep {
+	steps := []firehydrant.WorkflowStep{}
+
+	for _, step := range d.Get("steps").([]interface{}) {
+		s := step.(map[string]interface{})
+
+		steps = append(steps, firehydrant.WorkflowStep{
+			Name:     s["name"].(string),
+			ActionID: s["action_id"].(string),
+			Config:   convertStringMap(s["config"].(map[string]interface{})),
+		})
+	}
+
+	return steps
+}
+
+// workflowRoleAssignmentsFromState builds the workflow's role assignments, checking that each assigns
+// its role to either a user or a schedule
+func workflowRoleAssignmentsFromState(d *schema.ResourceData) ([]firehydrant.WorkflowRoleAssignment, error) {
+	assignments := []firehydrant.WorkflowRoleAssignment{}
+
+	for _, assignment := range d.Get("role_assignment").(*schema.Set).List() {
+		a := assignment.(map[string]interface{})
+
+		roleAssignment := firehydrant.WorkflowRoleAssignment{
+			IncidentRoleID: a["incident_role_id"].(string),
+			UserID:         a["user_id"].(string),
+			ScheduleID:     a["schedule_id"].(string),
+		}
+
+		if (roleAssignment.UserID == "") == (roleAssignment.ScheduleID == "") {
+			return nil, fmt.Errorf("role_assignment for incident role %q has to set exactly one of user_id or schedule_id", roleAssignment.IncidentRoleID)
+		}
+
+		assignments = append(assignments, roleAssignment)
+	}
+
+	return assignments, nil
+}
+
+func convertWorkflowToState(workflow *firehydrant.WorkflowResponse, d *schema.ResourceData) error {
+	conditions := make([]interface{}, len(workflow.Trigger.Conditions))
+	for index, c := range workflow.Trigger.Conditions {
+		conditions[index] = map[string]interface{}{
+			"field":    c.Field,
+			"operator": c.Operator,
+			"value":    c.Value,
+		}
+	}
+
+	steps := make([]interface{}, len(workflow.Steps))
+	for index, s := range workflow.Steps {
+		stepConfig := map[string]interface{}{}
+		for k, v := range s.Config {
+			stepConfig[k] = v
+		}
+
+		steps[index] = map[string]interface{}{
+			"step_id":   s.ID,
+			"name":      s.Name,
+			"action_id": s.ActionID,
+			"config":    stepConfig,
+		}
+	}
+
+	roleAssignments := make([]interface{}, len(workflow.RoleAssignments))
+	for index, a := range workflow.RoleAssignments {
+		roleAssignments[index] = map[string]interface{}{
+			"incident_role_id": a.IncidentRoleID,
+			"user_id":          a.UserID,
+			"schedule_id":      a.ScheduleID,
+		}
+	}
+
+	attributes := map[string]interface{}{
+		"name":        workflow.Name,
+		"description": workflow.Description,
+		"trigger": []interface{}{
+			map[string]interface{}{
+				"event":     workflow.Trigger.Event,
+				"condition": conditions,
+			},
+		},
+		"steps":           steps,
+		"role_assignment": roleAssignments,
+	}
+
+	return setAttributesFromMap(d, attributes)
+}
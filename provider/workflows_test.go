@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccWorkflows(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testFireHydrantIsSetup(t) },
+		ProviderFactories: defaultProviderFactories(),
+		CheckDestroy:      testWorkflowDoesNotExist("firehydrant_workflow.terraform-acceptance-test-workflow"),
+		Steps: []resource.TestStep{
+			{
+				Config: testWorkflowConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testWorkflowExists("firehydrant_workflow.terraform-acceptance-test-workflow"),
+					resource.TestCheckResourceAttr("firehydrant_workflow.terraform-acceptance-test-workflow", "name", rName),
+					resource.TestCheckResourceAttr("firehydrant_workflow.terraform-acceptance-test-workflow", "trigger.0.event", "incident.opened"),
+					resource.TestCheckResourceAttr("firehydrant_workflow.terraform-acceptance-test-workflow", "trigger.0.condition.#", "1"),
+					resource.TestCheckResourceAttr("firehydrant_workflow.terraform-acceptance-test-workflow", "steps.#", "1"),
+					resource.TestCheckResourceAttrSet("firehydrant_workflow.terraform-acceptance-test-workflow", "steps.0.step_id"),
+				),
+			},
+			{
+				Config: testWorkflowConfig(rName + " updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testWorkflowExists("firehydrant_workflow.terraform-acceptance-test-workflow"),
+					resource.TestCheckResourceAttr("firehydrant_workflow.terraform-acceptance-test-workflow", "name", rName+" updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestWorkflowRoleAssignments(t *testing.T) {
+	workflow := firehydrant.WorkflowResponse{
+		ID:      "test-workflow-id",
+		Name:    "workflow",
+		Trigger: firehydrant.WorkflowTrigger{Event: "incident.opened", Conditions: []firehydrant.WorkflowCondition{}},
+		Steps:   []firehydrant.WorkflowStep{{ID: "test-step-id", Name: "step", ActionID: "test-action-id"}},
+	}
+
+	var updates []map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodPost:
+			var created firehydrant.CreateWorkflowRequest
+			if err := json.NewDecoder(req.Body).Decode(&created); err != nil {
+				t.Errorf("Could not decode request: %s", err)
+			}
+			workflow.RoleAssignments = created.RoleAssignments
+		case http.MethodPatch:
+			var updated map[string]interface{}
+			if err := json.NewDecoder(req.Body).Decode(&updated); err != nil {
+				t.Errorf("Could not decode request: %s", err)
+			}
+			updates = append(updates, updated)
+		}
+		json.NewEncoder(w).Encode(workflow)
+	}))
+	defer ts.Close()
+
+	ac, err := firehydrant.NewRestClient("testing-123", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	config := func(assignments ...interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"name":            "workflow",
+			"trigger":         []interface{}{map[string]interface{}{"event": "incident.opened"}},
+			"steps":           []interface{}{map[string]interface{}{"name": "step", "action_id": "test-action-id"}},
+			"role_assignment": assignments,
+		}
+	}
+
+	r := resourceWorkflow()
+	d := schema.TestResourceDataRaw(t, r.Schema, config(
+		map[string]interface{}{"incident_role_id": "commander", "user_id": "test-user-id"},
+		map[string]interface{}{"incident_role_id": "comms", "schedule_id": "test-schedule-id"},
+	))
+
+	if diags := createResourceFireHydrantWorkflow(context.TODO(), d, ac); diags.HasError() {
+		t.Fatalf("Received error creating workflow: %+v", diags)
+	}
+
+	if got := d.Get("role_assignment").(*schema.Set).Len(); got != 2 {
+		t.Fatalf("Expected 2 role assignments, Got: %d", got)
+	}
+
+	// Removing an assignment sends the ones left, so that FireHydrant removes it too
+	diff, err := r.Diff(context.TODO(), d.State(), terraform.NewResourceConfigRaw(config(
+		map[string]interface{}{"incident_role_id": "commander", "user_id": "test-user-id"},
+	)), ac)
+	if err != nil {
+		t.Fatalf("Received error planning the update: %s", err.Error())
+	}
+	if _, diags := r.Apply(context.TODO(), d.State(), diff, ac); diags.HasError() {
+		t.Fatalf("Received error updating workflow: %+v", diags)
+	}
+
+	expected := []interface{}{map[string]interface{}{"incident_role_id": "commander", "user_id": "test-user-id"}}
+	if len(updates) != 1 || !reflect.DeepEqual(expected, updates[0]["role_assignments"]) {
+		t.Fatalf("Expected %+v, Got: %+v for the role assignments sent", expected, updates)
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, config(
+		map[string]interface{}{"incident_role_id": "commander", "user_id": "test-user-id", "schedule_id": "test-schedule-id"},
+	))
+	if diags := createResourceFireHydrantWorkflow(context.TODO(), d, ac); !diags.HasError() {
+		t.Fatalf("Expected an error assigning a role to both a user and a schedule")
+	}
+}
+
+const testWorkflowConfigTemplate = `
+data "firehydrant_runbook_action" "create-incident-channel" {
+	slug = "create_incident_channel"
+	integration_slug = "slack"
+	type = "incident"
+}
+
+resource "firehydrant_workflow" "terraform-acceptance-test-workflow" {
+	name        = "%s"
+	description = "A workflow created by the acceptance tests"
+
+	trigger {
+		event = "incident.opened"
+
+		condition {
+			field    = "severity"
+			operator = "equals"
+			value    = "SEV1"
+		}
+	}
+
+	steps {
+		name      = "Create Incident Channel"
+		action_id = data.firehydrant_runbook_action.create-incident-channel.id
+		config = {
+			channel_name_format = "-inc-{{ number }}"
+		}
+	}
+}
+`
+
+func testWorkflowConfig(rName string) string {
+	return fmt.Sprintf(testWorkflowConfigTemplate, rName)
+}
+
+func testWorkflowExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		workflow, err := c.Workflows().Get(context.TODO(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if expected, got := rs.Primary.Attributes["name"], workflow.Name; expected != got {
+			return fmt.Errorf("Unexpected name. Expected: %s, got: %s", expected, got)
+		}
+
+		if expected, got := rs.Primary.Attributes["steps.#"], fmt.Sprint(len(workflow.Steps)); expected != got {
+			return fmt.Errorf("Expected %s steps, got %s", expected, got)
+		}
+
+		return nil
+	}
+}
+
+func testWorkflowDoesNotExist(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return nil
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID was not set")
+		}
+
+		c, err := firehydrant.NewRestClient(os.Getenv("FIREHYDRANT_API_KEY"))
+		if err != nil {
+			return err
+		}
+
+		workflow, err := c.Workflows().Get(context.TODO(), rs.Primary.ID)
+		if workflow != nil {
+			return fmt.Errorf("The workflow existed, when it should not")
+		}
+
+		if !firehydrant.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}
+}